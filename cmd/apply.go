@@ -3,37 +3,47 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+	workv1 "open-cluster-management.io/api/work/v1"
 
 	"github.com/openshift-hyperfleet/maestro-cli/internal/maestro"
 	"github.com/openshift-hyperfleet/maestro-cli/internal/manifestwork"
+	clierrors "github.com/openshift-hyperfleet/maestro-cli/pkg/errors"
 	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
 )
 
 // ApplyFlags contains flags for the apply command
 type ApplyFlags struct {
-	ManifestFile string
-	Consumer     string
-	Wait         string // Condition to wait for (empty = no wait)
-	// Global flags
-	GRPCEndpoint        string
-	HTTPEndpoint        string
-	GRPCInsecure        bool
-	GRPCServerCAFile    string
-	GRPCClientCertFile  string
-	GRPCClientKeyFile   string
-	GRPCBrokerCAFile    string
-	GRPCClientToken     string
-	GRPCClientTokenFile string
-	SourceID            string
-	ResultsPath         string
-	Output              string
-	Timeout             time.Duration
-	Verbose             bool
+	ManifestFile string   // File, directory or "-" for stdin
+	Filenames    []string // Plain Kubernetes manifests to wrap into a ManifestWork named Name
+	Name         string   // Name of the ManifestWork wrapping Filenames
+	Recursive    bool     // Read subdirectories of a ManifestFile or Filenames directory
+	AutoFeedback bool     // Inject default feedback rules for well-known kinds
+	DryRun       bool     // Print the ManifestWorks instead of applying them
+	// AllowRecreate deletes (orphaning resources) and recreates works whose manifest count changed
+	AllowRecreate bool
+	// Preconditions on the version of existing works (--expected-version, --if-unchanged-since)
+	Preconditions maestro.ApplyPreconditions
+	// RetryOnConflict is the number of times a work is re-fetched, re-merged and applied again after a conflict
+	RetryOnConflict int
+	Force           bool // Update works even when their spec hash is unchanged
+	Atomic          bool // Roll back works whose wait condition fails or times out
+	Consumer        string
+	Wait            string        // Condition to wait for (empty = no wait)
+	FailOn          string        // Condition that fails the wait as soon as it is met
+	StableFor       time.Duration // How long the condition must hold continuously
+	FanOut          FanOutFlags
+	GlobalFlags
 }
 
 // NewApplyCommand creates the apply command
@@ -43,6 +53,24 @@ func NewApplyCommand() *cobra.Command {
 		Short: "Apply a ManifestWork to a target cluster",
 		Long: `Apply a ManifestWork resource to a target cluster via Maestro.
 Creates a new ManifestWork or updates an existing one with the same name.
+A ManifestWork that already matches the existing one is left unchanged.
+
+--manifest-file accepts a file, a directory of .yaml/.yml/.json files
+(--recursive to include subdirectories) or - to read from stdin. Files may
+hold several ManifestWorks separated by ---. When more than one ManifestWork
+is found, each is applied in turn, --wait is evaluated for all of them and a
+summary of created, updated, unchanged and failed works is printed.
+
+Instead of a ManifestWork, plain Kubernetes manifests can be given with -f
+(repeatable; files, directories or -) and are wrapped into a ManifestWork
+named by --name. Besides Kubernetes objects and v1 Lists, -f accepts the
+spec, manifests and workload shapes also understood by 'build'.
+
+Conditions on resources (e.g. --wait=Job:Complete) need status feedback, which
+is configured by spec.manifestConfigs[].feedbackRules. --auto-feedback adds
+default rules for Jobs, Deployments, StatefulSets, DaemonSets, Namespaces,
+PersistentVolumeClaims and CustomResourceDefinitions that have none; use
+--dry-run to see the resulting ManifestWork without applying it.
 
 Examples:
   # Apply a ManifestWork (no wait)
@@ -58,28 +86,65 @@ Examples:
   maestro-cli apply --manifest-file=job.yaml --consumer=cluster-west-1 \
     --wait="Job:Complete OR Job:Failed"
 
+  # Add feedback rules for the Job and show the result without applying
+  maestro-cli apply --manifest-file=job.yaml --consumer=cluster-west-1 --auto-feedback --dry-run
+
   # Apply with timeout (default 5m if not specified)
   maestro-cli apply --manifest-file=nodepool.yaml --consumer=cluster-west-1 \
-    --wait --timeout=10m --results-path=/shared/results.json`,
+    --wait --timeout=10m --results-path=/shared/results.json
+
+  # Wrap existing kubectl manifests into a ManifestWork named nginx
+  maestro-cli apply --name=nginx -f deployment.yaml -f service.yaml --consumer=cluster-west-1
+
+  # Apply every ManifestWork of a directory tree and wait for all of them
+  maestro-cli apply --manifest-file=works/ --recursive --consumer=cluster-west-1 --wait
+
+  # Apply a multi-document stream from stdin
+  cat works.yaml | maestro-cli apply --manifest-file=- --consumer=cluster-west-1
+
+  # Apply to several clusters concurrently, stopping at the first failure
+  maestro-cli apply --manifest-file=nodepool.yaml --consumers=cluster-west-1,cluster-east-1 --fail-fast
+
+  # Apply to every cluster labelled env=prod, 5 at a time
+  maestro-cli apply --manifest-file=nodepool.yaml --consumer-selector=env=prod --parallelism=5 --wait`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			globals, err := loadGlobalFlags(cmd)
+			if err != nil {
+				return err
+			}
+			fanOut := getFanOutFlags(cmd)
+			consumer, err := resolveTargetConsumer(cmd, &globals, &fanOut)
+			if err != nil {
+				return err
+			}
+			filenames, _ := cmd.Flags().GetStringArray("filename")
+			preconditions, err := getApplyPreconditions(cmd)
+			if err != nil {
+				return err
+			}
+			retryOnConflict := getIntFlag(cmd, "retry-on-conflict")
+			if retryOnConflict < 0 {
+				return clierrors.NewValidationFailed("--retry-on-conflict must not be negative")
+			}
+
 			flags := &ApplyFlags{
-				ManifestFile:        getStringFlag(cmd, "manifest-file"),
-				Consumer:            getStringFlag(cmd, "consumer"),
-				Wait:                getStringFlag(cmd, "wait"),
-				GRPCEndpoint:        getStringFlag(cmd, "grpc-endpoint"),
-				HTTPEndpoint:        getStringFlag(cmd, "http-endpoint"),
-				GRPCInsecure:        getBoolFlag(cmd, "grpc-insecure"),
-				GRPCServerCAFile:    getStringFlag(cmd, "grpc-server-ca-file"),
-				GRPCClientCertFile:  getStringFlag(cmd, "grpc-client-cert-file"),
-				GRPCClientKeyFile:   getStringFlag(cmd, "grpc-client-key-file"),
-				GRPCBrokerCAFile:    getStringFlag(cmd, "grpc-broker-ca-file"),
-				GRPCClientToken:     getStringFlag(cmd, "grpc-client-token"),
-				GRPCClientTokenFile: getStringFlag(cmd, "grpc-client-token-file"),
-				SourceID:            getStringFlag(cmd, "source-id"),
-				ResultsPath:         getStringFlag(cmd, "results-path"),
-				Output:              getStringFlag(cmd, "output"),
-				Timeout:             getDurationFlag(cmd, "timeout"),
-				Verbose:             getBoolFlag(cmd, "verbose"),
+				ManifestFile:    getStringFlag(cmd, "manifest-file"),
+				Filenames:       filenames,
+				Name:            getStringFlag(cmd, "name"),
+				Recursive:       getBoolFlag(cmd, "recursive"),
+				AutoFeedback:    getBoolFlag(cmd, "auto-feedback"),
+				DryRun:          getBoolFlag(cmd, "dry-run"),
+				AllowRecreate:   getBoolFlag(cmd, "allow-recreate"),
+				Preconditions:   preconditions,
+				RetryOnConflict: retryOnConflict,
+				Force:           getBoolFlag(cmd, "force"),
+				Atomic:          getBoolFlag(cmd, "atomic"),
+				Consumer:        consumer,
+				Wait:            getStringFlag(cmd, "wait"),
+				FailOn:          getStringFlag(cmd, "fail-on"),
+				StableFor:       getDurationFlag(cmd, "stable-for"),
+				FanOut:          fanOut,
+				GlobalFlags:     globals,
 			}
 
 			return runApplyCommand(cmd.Context(), flags)
@@ -87,20 +152,39 @@ Examples:
 	}
 
 	// Command-specific flags
-	cmd.Flags().String("manifest-file", "", "Path to ManifestWork YAML/JSON file (required)")
-	cmd.Flags().String("consumer", "", "Target cluster name (required)")
+	cmd.Flags().String("manifest-file", "",
+		"Path to a ManifestWork YAML/JSON file, a directory of them, or - for stdin")
+	cmd.Flags().StringArrayP("filename", "f", nil,
+		"Kubernetes manifest file, directory or - for stdin to wrap into the ManifestWork named by --name (repeatable)")
+	cmd.Flags().String("name", "", "Name of the ManifestWork wrapping the -f manifests")
+	cmd.Flags().BoolP("recursive", "R", false, "Read subdirectories of --manifest-file and -f directories")
+	cmd.Flags().String("consumer", "", "Target cluster name (defaults to the consumer of the config context)")
 	cmd.Flags().String(
 		"wait", "", "Wait for condition before exit (e.g., 'Available', 'Job:Complete', 'Job:Complete OR Job:Failed')",
 	)
 	cmd.Flags().Lookup("wait").NoOptDefVal = "Available" // Default when --wait is used without value
-
-	// Mark required flags
-	if err := cmd.MarkFlagRequired("manifest-file"); err != nil {
-		panic(err)
-	}
-	if err := cmd.MarkFlagRequired("consumer"); err != nil {
-		panic(err)
-	}
+	addFailOnFlag(cmd)
+	addStableForFlag(cmd)
+	cmd.Flags().Bool("auto-feedback", false,
+		"Add default status feedback rules for well-known kinds (Job, Deployment, Namespace, ...) that have none")
+	cmd.Flags().Bool("dry-run", false, "Print the ManifestWorks that would be applied without applying them")
+	addAllowRecreateFlag(cmd)
+	cmd.Flags().Int32("expected-version", 0,
+		"Apply only if the ManifestWork has this resource bundle version (0: only if it does not exist)")
+	cmd.Flags().String("if-unchanged-since", "",
+		"Apply only if the ManifestWork was not updated after this RFC3339 time")
+	cmd.Flags().Int("retry-on-conflict", 0,
+		"Re-fetch, re-merge and retry up to N times when the ManifestWork was modified concurrently")
+	cmd.Flags().Lookup("retry-on-conflict").NoOptDefVal = strconv.Itoa(defaultConflictRetries)
+	cmd.Flags().Bool("force", false, "Update ManifestWorks even when their spec hash shows no change")
+	cmd.Flags().Bool("atomic", false,
+		"Roll back when the --wait condition fails or times out: delete created works, restore updated ones")
+	addFanOutFlags(cmd)
+
+	// Either a ManifestWork file or manifests to wrap under a name
+	cmd.MarkFlagsOneRequired("manifest-file", "filename")
+	cmd.MarkFlagsMutuallyExclusive("manifest-file", "filename")
+	cmd.MarkFlagsRequiredTogether("filename", "name")
 
 	return cmd
 }
@@ -122,17 +206,60 @@ func runApplyCommand(ctx context.Context, flags *ApplyFlags) error {
 		Version:   "dev",
 	})
 
-	// Load ManifestWork from file
-	mw, err := manifestwork.LoadFromFile(flags.ManifestFile)
+	if flags.Atomic && flags.Wait == "" {
+		return clierrors.NewValidationFailed("cannot use --atomic without --wait")
+	}
+	if flags.FailOn != "" && flags.Wait == "" {
+		return clierrors.NewValidationFailed("cannot use --fail-on without --wait")
+	}
+	if flags.StableFor != 0 && flags.Wait == "" {
+		return clierrors.NewValidationFailed("cannot use --stable-for without --wait")
+	}
+
+	// Compile the wait conditions up front so syntax errors are reported before applying
+	if flags.Wait != "" {
+		if err := validateWaitConditions(flags.Wait, flags.FailOn, flags.StableFor); err != nil {
+			return err
+		}
+	}
+
+	works, err := loadApplyManifestWorks(flags)
 	if err != nil {
 		log.Error(ctx, err, "Failed to load manifest file", logger.Fields{
 			"manifest_file": flags.ManifestFile,
+			"filenames":     flags.Filenames,
 		})
-		return fmt.Errorf("failed to load manifest file: %w", err)
+		return err
 	}
+	if flags.AutoFeedback {
+		for _, mw := range works {
+			if err := addFeedbackRules(ctx, mw, log); err != nil {
+				return err
+			}
+		}
+	}
+	if flags.DryRun {
+		return outputDryRunManifestWorks(works, flags.Output)
+	}
+	if len(works) > 1 {
+		if flags.FanOut.enabled() {
+			return clierrors.NewValidationFailed(
+				"%s holds %d ManifestWorks; applying to multiple consumers supports a single ManifestWork",
+				flags.ManifestFile, len(works))
+		}
+		if flags.Preconditions.ExpectedVersion != nil {
+			return clierrors.NewValidationFailed(
+				"%s holds %d ManifestWorks; --expected-version supports a single ManifestWork",
+				flags.ManifestFile, len(works))
+		}
+		return runApplyManyCommand(ctx, flags, works, log)
+	}
+	mw := works[0]
 
-	// Add cluster context for logging
-	ctx = logger.ContextWithClusterID(ctx, flags.Consumer)
+	// Add cluster context for logging (set per consumer when fanning out)
+	if flags.Consumer != "" {
+		ctx = logger.ContextWithClusterID(ctx, flags.Consumer)
+	}
 	ctx = logger.ContextWithResource(ctx, "manifestwork", mw.Name)
 
 	log.Info(ctx, "Loaded ManifestWork", logger.Fields{
@@ -142,18 +269,7 @@ func runApplyCommand(ctx context.Context, flags *ApplyFlags) error {
 	})
 
 	// Create Maestro client (passes context for proper signal handling)
-	client, err := maestro.NewClient(ctx, maestro.ClientConfig{
-		GRPCEndpoint:        flags.GRPCEndpoint,
-		HTTPEndpoint:        flags.HTTPEndpoint,
-		GRPCInsecure:        flags.GRPCInsecure,
-		GRPCServerCAFile:    flags.GRPCServerCAFile,
-		GRPCBrokerCAFile:    flags.GRPCBrokerCAFile,
-		GRPCClientCertFile:  flags.GRPCClientCertFile,
-		GRPCClientKeyFile:   flags.GRPCClientKeyFile,
-		GRPCClientToken:     flags.GRPCClientToken,
-		GRPCClientTokenFile: flags.GRPCClientTokenFile,
-		SourceID:            flags.SourceID,
-	})
+	client, err := maestro.NewClient(ctx, flags.clientConfig())
 	if err != nil {
 		log.Error(ctx, err, "Failed to create Maestro client", logger.Fields{
 			"grpc_endpoint": flags.GRPCEndpoint,
@@ -167,6 +283,10 @@ func runApplyCommand(ctx context.Context, flags *ApplyFlags) error {
 		}
 	}()
 
+	if flags.FanOut.enabled() {
+		return runApplyFanOut(ctx, client, flags, mw, log)
+	}
+
 	// Validate consumer exists
 	if err := client.ValidateConsumer(ctx, flags.Consumer); err != nil {
 		log.Error(ctx, err, "Consumer validation failed", logger.Fields{
@@ -176,7 +296,7 @@ func runApplyCommand(ctx context.Context, flags *ApplyFlags) error {
 	}
 
 	// Apply ManifestWork
-	applyResult, err := client.ApplyManifestWork(ctx, flags.Consumer, mw, log)
+	applyResult, steps, err := applyManifestWork(ctx, client, flags.Consumer, mw, flags.applyOptions(), log)
 	if err != nil {
 		if writeErr := manifestwork.WriteResult(flags.ResultsPath, manifestwork.StatusResult{
 			Name:      mw.Name,
@@ -184,6 +304,7 @@ func runApplyCommand(ctx context.Context, flags *ApplyFlags) error {
 			Status:    "Failed",
 			Message:   err.Error(),
 			Timestamp: time.Now(),
+			Steps:     steps,
 		}); writeErr != nil {
 			log.Warn(ctx, "Failed to write results file", logger.Fields{
 				"results_path": flags.ResultsPath,
@@ -200,6 +321,7 @@ func runApplyCommand(ctx context.Context, flags *ApplyFlags) error {
 
 	log.Info(ctx, "ManifestWork applied successfully", logger.Fields{
 		"manifest_name":    applyResult.Name,
+		"operation":        applyResult.Operation,
 		"resource_version": applyResult.ResourceVersion,
 		"generation":       applyResult.Generation,
 	})
@@ -208,9 +330,12 @@ func runApplyCommand(ctx context.Context, flags *ApplyFlags) error {
 	if writeErr := manifestwork.WriteResult(flags.ResultsPath, manifestwork.StatusResult{
 		Name:      mw.Name,
 		Consumer:  flags.Consumer,
+		Operation: applyResult.Operation,
+		Changed:   manifestwork.OperationChanged(applyResult.Operation),
 		Status:    "Applied",
 		Message:   "ManifestWork applied successfully",
 		Timestamp: time.Now(),
+		Steps:     steps,
 	}); writeErr != nil {
 		log.Error(ctx, writeErr, "Failed to write results file", logger.Fields{
 			"results_path": flags.ResultsPath,
@@ -219,7 +344,7 @@ func runApplyCommand(ctx context.Context, flags *ApplyFlags) error {
 	}
 
 	// Wait for condition if requested (using HTTP polling, like kubectl wait)
-	if flags.Wait != "" {
+	if waitFor := applyWaitCondition(flags.Wait, applyResult); waitFor != "" {
 		// Use timeout if specified, otherwise default to 5 minutes
 		waitTimeout := flags.Timeout
 		if waitTimeout == 0 {
@@ -227,33 +352,65 @@ func runApplyCommand(ctx context.Context, flags *ApplyFlags) error {
 		}
 
 		log.Info(ctx, "Waiting for condition", logger.Fields{
-			"condition": flags.Wait,
-			"timeout":   waitTimeout.String(),
+			"condition":  waitFor,
+			"fail_on":    flags.FailOn,
+			"stable_for": flags.StableFor.String(),
+			"timeout":    waitTimeout.String(),
 		})
 
 		// Create wait context with timeout
 		waitCtx, waitCancel := context.WithTimeout(ctx, waitTimeout)
 		defer waitCancel()
 
-		// Create callback to update results file on each poll
-		var callback maestro.WaitCallback
-		if flags.ResultsPath != "" || os.Getenv("RESULTS_PATH") != "" {
-			callback = func(details *maestro.ManifestWorkDetails, conditionMet bool) error {
-				status := "Waiting"
-				message := fmt.Sprintf("Waiting for condition '%s'", flags.Wait)
-				if conditionMet {
-					status = flags.Wait
-					message = fmt.Sprintf("Condition '%s' met", flags.Wait)
-				}
-				result := manifestwork.BuildStatusResult(mw.Name, flags.Consumer, status, message, details)
-				return manifestwork.WriteResult(flags.ResultsPath, result)
+		// Update the results file on each poll
+		writeResults := flags.ResultsPath != "" || os.Getenv("RESULTS_PATH") != ""
+		var lastDetails *maestro.ManifestWorkDetails
+		callback := func(details *maestro.ManifestWorkDetails, conditionMet bool) error {
+			lastDetails = details
+			if !writeResults {
+				return nil
+			}
+			status := "Waiting"
+			message := fmt.Sprintf("Waiting for condition '%s'", waitFor)
+			if conditionMet {
+				status = waitFor
+				message = fmt.Sprintf("Condition '%s' met", waitFor)
 			}
+			result := manifestwork.BuildStatusResult(mw.Name, flags.Consumer, status, message, details)
+			result.Operation = applyResult.Operation
+			result.Changed = manifestwork.OperationChanged(applyResult.Operation)
+			result.Steps = steps
+			return manifestwork.WriteResult(flags.ResultsPath, result)
 		}
 
 		// Poll every 2 seconds by default
-		if err := client.WaitForCondition(
-			waitCtx, flags.Consumer, mw.Name, flags.Wait, maestro.DefaultPollInterval, log, callback,
-		); err != nil {
+		err := client.WaitForConditionWithOptions(
+			waitCtx, flags.Consumer, mw.Name, waitFor, flags.waitOptions(), log, callback,
+		)
+		if err != nil && flags.Atomic {
+			result := failedResult(manifestwork.BuildStatusResult(
+				mw.Name, flags.Consumer, manifestwork.StatusFailed, "", lastDetails), mw.Name, flags.Consumer, err)
+			result.Operation = applyResult.Operation
+			result.Changed = manifestwork.OperationChanged(applyResult.Operation)
+			result.Steps = recreateWaitSteps(applyResult, steps, waitFor, err)
+			printConditionTrace(result)
+			result, err = rollbackApply(ctx, client, flags.Consumer, applyResult, result, err, log)
+			if writeErr := manifestwork.WriteResult(flags.ResultsPath, result); writeErr != nil {
+				log.Warn(ctx, "Failed to write results file", logger.Fields{
+					"results_path": flags.ResultsPath,
+					"error":        writeErr.Error(),
+				})
+			}
+			return err
+		}
+		if applyResult.Operation == maestro.ApplyOperationRecreated || err != nil {
+			result := newWaitResult(mw.Name, flags.Consumer, waitFor, applyResult, steps, lastDetails, err)
+			printConditionTrace(result)
+			if writeErr := manifestwork.WriteResult(flags.ResultsPath, result); writeErr != nil && err == nil {
+				return fmt.Errorf("failed to write results file: %w", writeErr)
+			}
+		}
+		if err != nil {
 			return err
 		}
 	}
@@ -261,6 +418,544 @@ func runApplyCommand(ctx context.Context, flags *ApplyFlags) error {
 	return nil
 }
 
+// runApplyFanOut applies the ManifestWork to every selected consumer concurrently
+func runApplyFanOut(
+	ctx context.Context,
+	client *maestro.Client,
+	flags *ApplyFlags,
+	mw *workv1.ManifestWork,
+	log *logger.Logger,
+) error {
+	consumers, err := resolveFanOutConsumers(ctx, client, &flags.FanOut)
+	if err != nil {
+		return err
+	}
+
+	log.Info(ctx, "Applying ManifestWork to consumers", logger.Fields{
+		"manifest_name": mw.Name,
+		"consumers":     len(consumers),
+		"parallelism":   flags.FanOut.Parallelism,
+		"fail_fast":     flags.FanOut.FailFast,
+	})
+
+	results, errs := runFanOut(ctx, consumers, mw.Name, &flags.FanOut, log,
+		func(ctx context.Context, consumer string) (manifestwork.StatusResult, error) {
+			return applyToConsumer(ctx, client, flags, consumer, mw, log)
+		})
+	return finishFanOut("apply", mw.Name, results, errs, &flags.FanOut, flags.ResultsPath)
+}
+
+// applyToConsumer applies a copy of the ManifestWork to one consumer and waits for the condition if requested
+func applyToConsumer(
+	ctx context.Context,
+	client *maestro.Client,
+	flags *ApplyFlags,
+	consumer string,
+	mw *workv1.ManifestWork,
+	log *logger.Logger,
+) (manifestwork.StatusResult, error) {
+	// Applying sets the namespace of the work, so every consumer gets its own copy
+	applied, steps, err := applyManifestWork(ctx, client, consumer, mw.DeepCopy(), flags.applyOptions(), log)
+	if err != nil {
+		return manifestwork.StatusResult{Steps: steps}, fmt.Errorf("failed to apply ManifestWork: %w", err)
+	}
+
+	waitFor := applyWaitCondition(flags.Wait, applied)
+	if waitFor == "" {
+		return manifestwork.StatusResult{
+			Name:      mw.Name,
+			Consumer:  consumer,
+			Operation: applied.Operation,
+			Changed:   manifestwork.OperationChanged(applied.Operation),
+			Status:    "Applied",
+			Message:   "ManifestWork applied successfully",
+			Timestamp: time.Now(),
+			Steps:     steps,
+		}, nil
+	}
+
+	waitTimeout := flags.Timeout
+	if waitTimeout == 0 {
+		waitTimeout = DefaultWaitTimeout
+	}
+	waitCtx, waitCancel := context.WithTimeout(ctx, waitTimeout)
+	defer waitCancel()
+
+	result, err := waitForApplied(waitCtx, client, waitFor, flags.waitOptions(), consumer, mw.Name, log)
+	result.Operation = applied.Operation
+	result.Changed = manifestwork.OperationChanged(applied.Operation)
+	result.Steps = recreateWaitSteps(applied, steps, waitFor, err)
+	if err != nil && flags.Atomic {
+		return rollbackApply(ctx, client, consumer, applied, result, err, log)
+	}
+	return result, err
+}
+
+// rollbackTimeout bounds the rollback of an atomic apply
+const rollbackTimeout = DefaultWaitTimeout
+
+// rollbackMessages describe a successful rollback by the operation that was rolled back
+var rollbackMessages = map[string]string{
+	maestro.ApplyOperationCreated:   "created ManifestWork deleted",
+	maestro.ApplyOperationUpdated:   "previous spec restored",
+	maestro.ApplyOperationRecreated: "previous ManifestWork recreated",
+}
+
+// rollbackApply rolls back an apply whose wait failed (--atomic)
+// The result records the rollback steps. The returned error is RolledBack or RollbackFailed,
+// or waitErr itself when the work was unchanged and there is nothing to roll back.
+func rollbackApply(
+	ctx context.Context,
+	client *maestro.Client,
+	consumer string,
+	applied *maestro.ApplyResult,
+	result manifestwork.StatusResult,
+	waitErr error,
+	log *logger.Logger,
+) (manifestwork.StatusResult, error) {
+	if applied.Operation == maestro.ApplyOperationUnchanged {
+		return result, waitErr
+	}
+
+	// The wait may have used up the deadline of ctx, so the rollback gets its own
+	rollbackCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), rollbackTimeout)
+	defer cancel()
+	steps, err := client.RollbackManifestWork(rollbackCtx, consumer, applied, maestro.DefaultPollInterval, log)
+
+	var rollbackErr error
+	if err != nil {
+		result.Status = manifestwork.StatusRollbackFailed
+		rollbackErr = clierrors.New(clierrors.TypeRollbackFailed, "%w; rollback failed: %v", waitErr, err)
+		log.Error(ctx, err, "Rollback failed", nil)
+	} else {
+		result.Status = manifestwork.StatusRolledBack
+		rollbackErr = clierrors.New(clierrors.TypeRolledBack, "%w; rolled back: %s",
+			waitErr, rollbackMessages[applied.Operation])
+		log.Info(ctx, "ManifestWork rolled back", logger.Fields{"operation": applied.Operation})
+	}
+	result.Message = rollbackErr.Error()
+	result.Rollback = steps
+	result.Timestamp = time.Now()
+	return result, rollbackErr
+}
+
+// waitOptions returns the wait options of the apply flags
+func (f *ApplyFlags) waitOptions() maestro.WaitOptions {
+	return maestro.WaitOptions{PollInterval: maestro.DefaultPollInterval, FailOn: f.FailOn, StableFor: f.StableFor}
+}
+
+// defaultConflictRetries is the number of conflict retries of --retry-on-conflict without a value
+const defaultConflictRetries = 3
+
+// applyOptions control how applyManifestWork applies a ManifestWork
+type applyOptions struct {
+	allowRecreate   bool
+	preconditions   maestro.ApplyPreconditions
+	retryOnConflict int
+	force           bool
+}
+
+// applyOptions returns the options of the apply flags
+func (f *ApplyFlags) applyOptions() applyOptions {
+	return applyOptions{
+		allowRecreate:   f.AllowRecreate,
+		preconditions:   f.Preconditions,
+		retryOnConflict: f.RetryOnConflict,
+		force:           f.Force,
+	}
+}
+
+// getApplyPreconditions reads --expected-version and --if-unchanged-since
+func getApplyPreconditions(cmd *cobra.Command) (maestro.ApplyPreconditions, error) {
+	var preconditions maestro.ApplyPreconditions
+	if cmd.Flags().Changed("expected-version") {
+		version := getInt32Flag(cmd, "expected-version")
+		if version < 0 {
+			return preconditions, clierrors.NewValidationFailed("--expected-version must not be negative")
+		}
+		preconditions.ExpectedVersion = &version
+	}
+	if since := getStringFlag(cmd, "if-unchanged-since"); since != "" {
+		parsed, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			return preconditions, clierrors.NewValidationFailed(
+				"invalid --if-unchanged-since %q (expected RFC3339, e.g. 2024-01-02T15:04:05Z): %w", since, err)
+		}
+		preconditions.UnchangedSince = parsed
+	}
+	return preconditions, nil
+}
+
+// applyManifestWork applies a ManifestWork to a consumer
+// When the number of manifests changed and allowRecreate is set, the work is recreated instead
+// and the steps carried out are returned. After a version conflict the work is re-fetched,
+// re-merged and applied again up to retryOnConflict times.
+func applyManifestWork(
+	ctx context.Context,
+	client *maestro.Client,
+	consumer string,
+	mw *workv1.ManifestWork,
+	opts applyOptions,
+	log *logger.Logger,
+) (*maestro.ApplyResult, []maestro.Step, error) {
+	for attempt := 1; ; attempt++ {
+		result, steps, err := applyManifestWorkOnce(ctx, client, consumer, mw, opts, log)
+		if err == nil || attempt > opts.retryOnConflict || !maestro.IsVersionConflict(err) {
+			return result, steps, err
+		}
+
+		log.Warn(ctx, "ManifestWork modified concurrently, retrying", logger.Fields{
+			"manifest_name": mw.Name,
+			"attempt":       attempt,
+			"error":         err.Error(),
+		})
+		timer := time.NewTimer(maestro.DefaultRetryPolicy().Backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, steps, err
+		case <-timer.C:
+		}
+
+		var version int32
+		if mw, version, err = remergeManifestWork(ctx, client, consumer, mw); err != nil {
+			return nil, steps, err
+		}
+		// The retry is based on the version that was merged with
+		opts.preconditions = maestro.ApplyPreconditions{ExpectedVersion: &version}
+	}
+}
+
+// applyManifestWorkOnce checks the preconditions and applies or recreates a ManifestWork
+func applyManifestWorkOnce(
+	ctx context.Context,
+	client *maestro.Client,
+	consumer string,
+	mw *workv1.ManifestWork,
+	opts applyOptions,
+	log *logger.Logger,
+) (*maestro.ApplyResult, []maestro.Step, error) {
+	if err := client.CheckManifestCount(ctx, consumer, mw); err != nil {
+		var countErr *maestro.ManifestCountChangedError
+		if !opts.allowRecreate || !errors.As(err, &countErr) {
+			return nil, nil, err
+		}
+		if !opts.preconditions.IsZero() {
+			if _, err := client.CheckApplyPreconditions(ctx, consumer, mw.Name, opts.preconditions); err != nil {
+				return nil, nil, err
+			}
+		}
+		log.Warn(ctx, "Manifest count changed, recreating ManifestWork", logger.Fields{
+			"manifest_name":      mw.Name,
+			"existing_manifests": countErr.Existing,
+			"desired_manifests":  countErr.Desired,
+		})
+		return client.RecreateManifestWork(ctx, consumer, mw, maestro.DefaultPollInterval, log)
+	}
+
+	result, err := client.ApplyManifestWorkWithOptions(ctx, consumer, mw, maestro.ApplyOptions{
+		Preconditions: opts.preconditions,
+		Force:         opts.force,
+	}, log)
+	return result, nil, err
+}
+
+// remergeManifestWork merges a ManifestWork into the current remote one after a conflict
+// Manifests added by the other writer are kept while the manifests, manifestConfigs, deleteOption,
+// labels and annotations of mw win. The version of the remote work (0 if it is gone) is returned.
+func remergeManifestWork(
+	ctx context.Context,
+	client *maestro.Client,
+	consumer string,
+	mw *workv1.ManifestWork,
+) (*workv1.ManifestWork, int32, error) {
+	current, err := client.GetManifestWork(ctx, consumer, mw.Name)
+	if apierrors.IsNotFound(err) {
+		return mw, 0, nil
+	}
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to re-fetch ManifestWork after conflict: %w", err)
+	}
+
+	merged, err := manifestwork.MergeManifestWorks(current, &manifestwork.SourceFile{Spec: &mw.Spec}, "merge")
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to re-merge ManifestWork after conflict: %w", err)
+	}
+	merged.Labels = mw.Labels
+	merged.Annotations = mw.Annotations
+	return merged, int32(current.Generation), nil //nolint:gosec // resource bundle versions are int32
+}
+
+// recreateWaitSteps records the wait for a recreated ManifestWork as the last step of the recreate
+func recreateWaitSteps(
+	result *maestro.ApplyResult,
+	steps []maestro.Step,
+	condition string,
+	err error,
+) []maestro.Step {
+	if result.Operation != maestro.ApplyOperationRecreated {
+		return steps
+	}
+	message := fmt.Sprintf("Condition '%s' met", condition)
+	return append(steps, maestro.NewStep(maestro.RecreateStepWait, message, err))
+}
+
+// newWaitResult returns the outcome of waiting for an applied ManifestWork
+// The wait for a recreated ManifestWork is recorded as the last step of the recreate.
+func newWaitResult(
+	name, consumer, condition string,
+	applied *maestro.ApplyResult,
+	steps []maestro.Step,
+	details *maestro.ManifestWorkDetails,
+	waitErr error,
+) manifestwork.StatusResult {
+	result := manifestwork.BuildStatusResult(
+		name, consumer, condition, fmt.Sprintf("Condition '%s' met", condition), details)
+	if waitErr != nil {
+		result = failedResult(result, name, consumer, waitErr)
+	}
+	result.Operation = applied.Operation
+	result.Changed = manifestwork.OperationChanged(applied.Operation)
+	result.Steps = recreateWaitSteps(applied, steps, condition, waitErr)
+	return result
+}
+
+// applyWaitCondition returns the condition to wait for after applying
+// A recreated ManifestWork is awaited until Applied even without --wait.
+func applyWaitCondition(wait string, result *maestro.ApplyResult) string {
+	if wait == "" && result.Operation == maestro.ApplyOperationRecreated {
+		return "Applied"
+	}
+	return wait
+}
+
+// addAllowRecreateFlag registers --allow-recreate
+func addAllowRecreateFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool("allow-recreate", false,
+		"Delete and recreate the ManifestWork, orphaning its resources, when its number of manifests changes")
+}
+
+// loadApplyManifestWorks loads the ManifestWorks of --manifest-file, or wraps the -f manifests into one
+func loadApplyManifestWorks(flags *ApplyFlags) ([]*workv1.ManifestWork, error) {
+	if len(flags.Filenames) == 0 {
+		works, err := manifestwork.LoadAll(flags.ManifestFile, flags.Recursive)
+		if err != nil {
+			return nil, clierrors.NewValidationFailed("failed to load manifest file: %w", err)
+		}
+		return works, nil
+	}
+
+	if errs := validation.IsDNS1123Subdomain(flags.Name); len(errs) > 0 {
+		return nil, clierrors.NewValidationFailed("invalid --name %q: %s", flags.Name, strings.Join(errs, "; "))
+	}
+	source, err := manifestwork.LoadManifests(flags.Filenames, flags.Recursive)
+	if err != nil {
+		return nil, clierrors.NewValidationFailed("failed to load manifests: %w", err)
+	}
+	return []*workv1.ManifestWork{source.ToManifestWork(flags.Name)}, nil
+}
+
+// addFeedbackRules injects the default feedback rules of well-known kinds and logs what was added
+func addFeedbackRules(ctx context.Context, mw *workv1.ManifestWork, log *logger.Logger) error {
+	injected, err := manifestwork.AddFeedbackRules(mw)
+	if err != nil {
+		return clierrors.NewValidationFailed("failed to add feedback rules to ManifestWork %s: %w", mw.Name, err)
+	}
+	for _, config := range injected {
+		log.Info(ctx, "Added feedback rules", logger.Fields{
+			"manifest_name": mw.Name,
+			"resource":      formatResourceIdentifier(config.ResourceIdentifier),
+		})
+	}
+	return nil
+}
+
+// formatResourceIdentifier renders a manifestConfig target as resource[.group] [namespace/]name
+func formatResourceIdentifier(id workv1.ResourceIdentifier) string {
+	resource := id.Resource
+	if id.Group != "" {
+		resource += "." + id.Group
+	}
+	name := id.Name
+	if id.Namespace != "" {
+		name = id.Namespace + "/" + name
+	}
+	return resource + " " + name
+}
+
+// outputDryRunManifestWorks prints the ManifestWorks that would be applied
+// YAML documents are separated by "---" so the output can be applied again with --manifest-file.
+func outputDryRunManifestWorks(works []*workv1.ManifestWork, format string) error {
+	for i, mw := range works {
+		if i > 0 && format != defaultOutputFormatJSON {
+			fmt.Println("---")
+		}
+		if err := outputManifestWork(mw, "", format); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// waitForApplied waits for a condition on an applied ManifestWork
+// The result carries the conditions of the last status seen.
+func waitForApplied(
+	ctx context.Context,
+	client *maestro.Client,
+	condition string,
+	opts maestro.WaitOptions,
+	consumer, name string,
+	log *logger.Logger,
+) (manifestwork.StatusResult, error) {
+	var lastDetails *maestro.ManifestWorkDetails
+	callback := func(details *maestro.ManifestWorkDetails, _ bool) error {
+		lastDetails = details
+		return nil
+	}
+	if err := client.WaitForConditionWithOptions(ctx, consumer, name, condition, opts, log, callback); err != nil {
+		return manifestwork.BuildStatusResult(name, consumer, manifestwork.StatusFailed, "", lastDetails),
+			fmt.Errorf("error waiting for condition '%s': %w", condition, err)
+	}
+
+	return manifestwork.BuildStatusResult(
+		name, consumer, condition, fmt.Sprintf("Condition '%s' met", condition), lastDetails,
+	), nil
+}
+
+// runApplyManyCommand applies several ManifestWorks to one consumer in turn
+// Every work is applied even if an earlier one fails; --wait is then evaluated for each applied
+// work within a single timeout, and a summary of the results is printed and written.
+func runApplyManyCommand(
+	ctx context.Context,
+	flags *ApplyFlags,
+	works []*workv1.ManifestWork,
+	log *logger.Logger,
+) error {
+	ctx = logger.ContextWithClusterID(ctx, flags.Consumer)
+
+	client, err := maestro.NewClient(ctx, flags.clientConfig())
+	if err != nil {
+		log.Error(ctx, err, "Failed to create Maestro client", logger.Fields{
+			"grpc_endpoint": flags.GRPCEndpoint,
+			"grpc_insecure": flags.GRPCInsecure,
+		})
+		return fmt.Errorf("failed to create Maestro client: %w", err)
+	}
+	defer func() {
+		if err := client.Close(); err != nil {
+			log.Warn(ctx, "Failed to close client", logger.Fields{"error": err.Error()})
+		}
+	}()
+
+	if err := client.ValidateConsumer(ctx, flags.Consumer); err != nil {
+		return err
+	}
+
+	log.Info(ctx, "Applying ManifestWorks", logger.Fields{
+		"manifest_file": flags.ManifestFile,
+		"consumer":      flags.Consumer,
+		"works":         len(works),
+	})
+
+	results := make([]manifestwork.StatusResult, len(works))
+	errs := make([]error, len(works))
+	applied := make([]*maestro.ApplyResult, len(works))
+	for i, mw := range works {
+		workCtx := logger.ContextWithResource(ctx, "manifestwork", mw.Name)
+		result, steps, err := applyManifestWork(workCtx, client, flags.Consumer, mw, flags.applyOptions(), log)
+		if err != nil {
+			errs[i] = fmt.Errorf("failed to apply ManifestWork: %w", err)
+			results[i] = failedResult(manifestwork.StatusResult{Steps: steps}, mw.Name, flags.Consumer, errs[i])
+			log.Error(workCtx, err, "Failed to apply ManifestWork", nil)
+			continue
+		}
+		applied[i] = result
+		results[i] = manifestwork.StatusResult{
+			Name:      mw.Name,
+			Consumer:  flags.Consumer,
+			Operation: result.Operation,
+			Changed:   manifestwork.OperationChanged(result.Operation),
+			Status:    "Applied",
+			Message:   "ManifestWork applied successfully",
+			Timestamp: time.Now(),
+			Steps:     steps,
+		}
+	}
+
+	waitTimeout := flags.Timeout
+	if waitTimeout == 0 {
+		waitTimeout = DefaultWaitTimeout
+	}
+	waitCtx, waitCancel := context.WithTimeout(ctx, waitTimeout)
+	defer waitCancel()
+
+	for i, mw := range works {
+		if errs[i] != nil {
+			continue
+		}
+		waitFor := applyWaitCondition(flags.Wait, applied[i])
+		if waitFor == "" {
+			continue
+		}
+		workCtx := logger.ContextWithResource(waitCtx, "manifestwork", mw.Name)
+		result, err := waitForApplied(workCtx, client, waitFor, flags.waitOptions(), flags.Consumer, mw.Name, log)
+		result.Operation = results[i].Operation
+		result.Changed = manifestwork.OperationChanged(results[i].Operation)
+		result.Steps = recreateWaitSteps(applied[i], results[i].Steps, waitFor, err)
+		if err != nil && flags.Atomic {
+			result, err = rollbackApply(workCtx, client, flags.Consumer, applied[i], result, err, log)
+		}
+		if err != nil {
+			result = failedResult(result, mw.Name, flags.Consumer, err)
+		}
+		results[i], errs[i] = result, err
+	}
+
+	summary := manifestwork.NewApplySummary(flags.Consumer, results)
+	if err := manifestwork.WriteApplySummary(flags.ResultsPath, summary); err != nil {
+		return fmt.Errorf("failed to write results file: %w", err)
+	}
+	if err := outputApplySummary(summary, flags.FanOut.SummaryFormat); err != nil {
+		return err
+	}
+	for _, result := range results {
+		printConditionTrace(result)
+	}
+
+	if summary.Failed == 0 {
+		return nil
+	}
+	var failed []string
+	for i, err := range errs {
+		if err != nil {
+			failed = append(failed, works[i].Name)
+		}
+	}
+	return clierrors.New(commonErrorType(errs), "%s (failed: %s)", summary.Message, strings.Join(failed, ", "))
+}
+
+// outputApplySummary prints the multi-ManifestWork result as a table, or as JSON/YAML when requested
+func outputApplySummary(summary manifestwork.ApplySummary, format string) error {
+	if handled, err := outputStructured(summary, format); handled {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAME\tOPERATION\tSTATUS\tMESSAGE")
+	for _, r := range summary.Results {
+		operation := r.Operation
+		if operation == "" {
+			operation = "-"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", r.Name, operation, r.Status, r.Message)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	fmt.Printf("\n%s: %s\n", summary.Status, summary.Message)
+	return nil
+}
+
 // getLogLevel determines the log level based on verbose flag
 func getLogLevel(verbose bool) string {
 	if verbose {
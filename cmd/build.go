@@ -14,6 +14,7 @@ import (
 
 	"github.com/openshift-hyperfleet/maestro-cli/internal/maestro"
 	"github.com/openshift-hyperfleet/maestro-cli/internal/manifestwork"
+	clierrors "github.com/openshift-hyperfleet/maestro-cli/pkg/errors"
 	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
 )
 
@@ -25,40 +26,30 @@ type BuildFlags struct {
 	OutputFile string
 	Strategy   string
 	Apply      bool
-	Wait       string // Condition to wait for (empty = no wait)
+	Wait       string        // Condition to wait for (empty = no wait)
+	FailOn     string        // Condition that fails the wait as soon as it is met
+	StableFor  time.Duration // How long the condition must hold continuously
 	DryRun     bool
 	Force      bool
-	// Global flags
-	GRPCEndpoint        string
-	HTTPEndpoint        string
-	GRPCInsecure        bool
-	GRPCServerCAFile    string
-	GRPCClientCertFile  string
-	GRPCClientKeyFile   string
-	GRPCBrokerCAFile    string
-	GRPCClientToken     string
-	GRPCClientTokenFile string
-	SourceID            string
-	ResultsPath         string
-	Output              string
-	Timeout             time.Duration
-	Verbose             bool
+	// AllowRecreate deletes (orphaning resources) and recreates the work when its manifest count changes
+	AllowRecreate bool
+	GlobalFlags
 }
 
 // NewBuildCommand creates the build command
-// NOTE: This command is currently disabled (hidden) because Maestro does not support
-// changing the number of manifests in a ManifestWork via patch/update.
-// The code is preserved for future research and potential re-enablement.
 func NewBuildCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:    "build",
-		Short:  "Build ManifestWork by merging configuration with remote state",
-		Hidden: true, // Disabled: Maestro doesn't support manifest count changes
+		Use:   "build",
+		Short: "Build ManifestWork by merging configuration with remote state",
 		Long: `Build a ManifestWork by fetching existing state from Maestro and merging with
 local configuration. Useful for updating specific resources while preserving others.
 
-NOTE: This command is currently disabled because Maestro does not support changing
-the number of manifests in a ManifestWork. Use delete + apply workflow instead.
+Maestro cannot change the number of manifests of an existing ManifestWork, so applying a
+build that adds or removes manifests fails with a conflict. With --allow-recreate the work
+is instead switched to the Orphan delete policy, awaited until the agent applied it, deleted,
+awaited until removed and created again, so its resources stay on the cluster; the recreated
+work is awaited until Applied (or the --wait condition) and each step is reported in the
+results file.
 
 The source file can be:
   - A full ManifestWork (YAML or JSON)
@@ -83,6 +74,10 @@ Examples:
   maestro-cli build --name=hyperfleet-cluster-west-1-nodepool --consumer=cluster-west-1 \
     --source-file=nodepool-config.json --apply --wait
 
+  # Add a manifest to an existing ManifestWork, recreating it without deleting its resources
+  maestro-cli build --name=hyperfleet-cluster-west-1-nodepool --consumer=cluster-west-1 \
+    --source-file=extra-manifest.yaml --apply --allow-recreate
+
   # Force replace strategy (replace entire spec)
   maestro-cli build --name=hyperfleet-cluster-west-1-nodepool --consumer=cluster-west-1 \
     --source-file=nodepool-config.json --strategy=replace --apply
@@ -91,31 +86,29 @@ Examples:
   maestro-cli build --name=new-manifestwork --consumer=cluster-west-1 \
     --source-file=full-manifestwork.yaml --force --apply`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			globals, err := loadGlobalFlags(cmd)
+			if err != nil {
+				return err
+			}
+			consumer, err := globals.consumer(cmd)
+			if err != nil {
+				return err
+			}
+
 			flags := &BuildFlags{
-				Name:       getStringFlag(cmd, "name"),
-				Consumer:   getStringFlag(cmd, "consumer"),
-				SourceFile: getStringFlag(cmd, "source-file"),
-				OutputFile: getStringFlag(cmd, "output-file"),
-				Strategy:   getStringFlag(cmd, "strategy"),
-				Apply:      getBoolFlag(cmd, "apply"),
-				Wait:       getStringFlag(cmd, "wait"),
-				DryRun:     getBoolFlag(cmd, "dry-run"),
-				Force:      getBoolFlag(cmd, "force"),
-				// Global flags
-				GRPCEndpoint:        getStringFlag(cmd, "grpc-endpoint"),
-				HTTPEndpoint:        getStringFlag(cmd, "http-endpoint"),
-				GRPCInsecure:        getBoolFlag(cmd, "grpc-insecure"),
-				GRPCServerCAFile:    getStringFlag(cmd, "grpc-server-ca-file"),
-				GRPCClientCertFile:  getStringFlag(cmd, "grpc-client-cert-file"),
-				GRPCClientKeyFile:   getStringFlag(cmd, "grpc-client-key-file"),
-				GRPCBrokerCAFile:    getStringFlag(cmd, "grpc-broker-ca-file"),
-				GRPCClientToken:     getStringFlag(cmd, "grpc-client-token"),
-				GRPCClientTokenFile: getStringFlag(cmd, "grpc-client-token-file"),
-				SourceID:            getStringFlag(cmd, "source-id"),
-				ResultsPath:         getStringFlag(cmd, "results-path"),
-				Output:              getStringFlag(cmd, "output"),
-				Timeout:             getDurationFlag(cmd, "timeout"),
-				Verbose:             getBoolFlag(cmd, "verbose"),
+				Name:          getStringFlag(cmd, "name"),
+				Consumer:      consumer,
+				SourceFile:    getStringFlag(cmd, "source-file"),
+				OutputFile:    getStringFlag(cmd, "output-file"),
+				Strategy:      getStringFlag(cmd, "strategy"),
+				Apply:         getBoolFlag(cmd, "apply"),
+				Wait:          getStringFlag(cmd, "wait"),
+				FailOn:        getStringFlag(cmd, "fail-on"),
+				StableFor:     getDurationFlag(cmd, "stable-for"),
+				DryRun:        getBoolFlag(cmd, "dry-run"),
+				Force:         getBoolFlag(cmd, "force"),
+				GlobalFlags:   globals,
+				AllowRecreate: getBoolFlag(cmd, "allow-recreate"),
 			}
 
 			return runBuildCommand(cmd.Context(), flags)
@@ -124,7 +117,7 @@ Examples:
 
 	// Command-specific flags
 	cmd.Flags().String("name", "", "ManifestWork name (required)")
-	cmd.Flags().String("consumer", "", "Target cluster name (required)")
+	cmd.Flags().String("consumer", "", "Target cluster name (defaults to the consumer of the config context)")
 	cmd.Flags().String("source-file", "", "Path to source configuration file - YAML or JSON (required)")
 	cmd.Flags().String("output-file", "", "Output file path (default: stdout)")
 	cmd.Flags().String("strategy", "merge", "Merge strategy: merge or replace")
@@ -133,16 +126,16 @@ Examples:
 		"wait", "", "Wait for condition after applying (e.g., 'Available', 'Job:Complete') - requires --apply",
 	)
 	cmd.Flags().Lookup("wait").NoOptDefVal = "Available" // Default when --wait is used without value
+	addFailOnFlag(cmd)
+	addStableForFlag(cmd)
 	cmd.Flags().Bool("dry-run", false, "Show what would be built without making changes")
 	cmd.Flags().Bool("force", false, "Create new ManifestWork if it doesn't exist")
+	addAllowRecreateFlag(cmd)
 
 	// Mark required flags
 	if err := cmd.MarkFlagRequired("name"); err != nil {
 		panic(err)
 	}
-	if err := cmd.MarkFlagRequired("consumer"); err != nil {
-		panic(err)
-	}
 	if err := cmd.MarkFlagRequired("source-file"); err != nil {
 		panic(err)
 	}
@@ -171,6 +164,13 @@ func runBuildCommand(ctx context.Context, flags *BuildFlags) error {
 	ctx = logger.ContextWithClusterID(ctx, flags.Consumer)
 	ctx = logger.ContextWithResource(ctx, "manifestwork", flags.Name)
 
+	// Compile the wait conditions up front so syntax errors are reported before applying
+	if flags.Wait != "" {
+		if err := validateWaitConditions(flags.Wait, flags.FailOn, flags.StableFor); err != nil {
+			return err
+		}
+	}
+
 	// Load source file
 	log.Info(ctx, "Loading source file", logger.Fields{
 		"source_file": flags.SourceFile,
@@ -181,22 +181,11 @@ func runBuildCommand(ctx context.Context, flags *BuildFlags) error {
 		log.Error(ctx, err, "Failed to load source file", logger.Fields{
 			"source_file": flags.SourceFile,
 		})
-		return fmt.Errorf("failed to load source file: %w", err)
+		return clierrors.NewValidationFailed("failed to load source file: %w", err)
 	}
 
 	// Create gRPC client for ManifestWork operations (passes context for proper signal handling)
-	client, err := maestro.NewClient(ctx, maestro.ClientConfig{
-		GRPCEndpoint:        flags.GRPCEndpoint,
-		HTTPEndpoint:        flags.HTTPEndpoint,
-		GRPCInsecure:        flags.GRPCInsecure,
-		GRPCServerCAFile:    flags.GRPCServerCAFile,
-		GRPCBrokerCAFile:    flags.GRPCBrokerCAFile,
-		GRPCClientCertFile:  flags.GRPCClientCertFile,
-		GRPCClientKeyFile:   flags.GRPCClientKeyFile,
-		GRPCClientToken:     flags.GRPCClientToken,
-		GRPCClientTokenFile: flags.GRPCClientTokenFile,
-		SourceID:            flags.SourceID,
-	})
+	client, err := maestro.NewClient(ctx, flags.clientConfig())
 	if err != nil {
 		log.Error(ctx, err, "Failed to create Maestro client", nil)
 		return fmt.Errorf("failed to create Maestro client: %w", err)
@@ -229,7 +218,7 @@ func runBuildCommand(ctx context.Context, flags *BuildFlags) error {
 					"name":     flags.Name,
 					"consumer": flags.Consumer,
 				})
-				return fmt.Errorf("ManifestWork %s not found in consumer %s (use --force to create new)",
+				return clierrors.NewNotFound("ManifestWork %s not found in consumer %s (use --force to create new)",
 					flags.Name, flags.Consumer,
 				)
 			}
@@ -274,9 +263,18 @@ func runBuildCommand(ctx context.Context, flags *BuildFlags) error {
 		"strategy":  flags.Strategy,
 	})
 
-	// Early guard: cannot use --wait without --apply
+	// Early guard: cannot use --wait or --allow-recreate without --apply
 	if flags.Wait != "" && !flags.Apply {
-		return fmt.Errorf("cannot use --wait without --apply")
+		return clierrors.NewValidationFailed("cannot use --wait without --apply")
+	}
+	if flags.AllowRecreate && !flags.Apply {
+		return clierrors.NewValidationFailed("cannot use --allow-recreate without --apply")
+	}
+	if flags.FailOn != "" && flags.Wait == "" {
+		return clierrors.NewValidationFailed("cannot use --fail-on without --wait")
+	}
+	if flags.StableFor != 0 && flags.Wait == "" {
+		return clierrors.NewValidationFailed("cannot use --stable-for without --wait")
 	}
 
 	// Dry run - just show what would happen
@@ -296,7 +294,8 @@ func runBuildCommand(ctx context.Context, flags *BuildFlags) error {
 		"consumer": flags.Consumer,
 	})
 
-	result, err := client.ApplyManifestWork(ctx, flags.Consumer, existing, log)
+	opts := applyOptions{allowRecreate: flags.AllowRecreate}
+	result, steps, err := applyManifestWork(ctx, client, flags.Consumer, existing, opts, log)
 	if err != nil {
 		writeErr := manifestwork.WriteResult(flags.ResultsPath, manifestwork.StatusResult{
 			Name:      existing.Name,
@@ -304,6 +303,7 @@ func runBuildCommand(ctx context.Context, flags *BuildFlags) error {
 			Status:    "Failed",
 			Message:   err.Error(),
 			Timestamp: time.Now(),
+			Steps:     steps,
 		})
 		if writeErr != nil {
 			log.Error(ctx, writeErr, "Failed to write results file", logger.Fields{
@@ -328,9 +328,12 @@ func runBuildCommand(ctx context.Context, flags *BuildFlags) error {
 	if err := manifestwork.WriteResult(flags.ResultsPath, manifestwork.StatusResult{
 		Name:      existing.Name,
 		Consumer:  flags.Consumer,
+		Operation: result.Operation,
+		Changed:   manifestwork.OperationChanged(result.Operation),
 		Status:    "Applied",
 		Message:   "ManifestWork built and applied successfully",
 		Timestamp: time.Now(),
+		Steps:     steps,
 	}); err != nil {
 		log.Error(ctx, err, "Failed to write results file", logger.Fields{
 			"path": flags.ResultsPath,
@@ -338,8 +341,8 @@ func runBuildCommand(ctx context.Context, flags *BuildFlags) error {
 		return fmt.Errorf("ManifestWork applied successfully but failed to write results: %w", err)
 	}
 
-	// Wait for condition if requested
-	if flags.Wait != "" {
+	// Wait for condition if requested; a recreated work is always awaited
+	if waitFor := applyWaitCondition(flags.Wait, result); waitFor != "" {
 		// Use timeout if specified, otherwise default to 5 minutes
 		waitTimeout := flags.Timeout
 		if waitTimeout == 0 {
@@ -347,7 +350,7 @@ func runBuildCommand(ctx context.Context, flags *BuildFlags) error {
 		}
 
 		log.Info(ctx, "Waiting for condition", logger.Fields{
-			"condition": flags.Wait,
+			"condition": waitFor,
 			"timeout":   waitTimeout.String(),
 		})
 
@@ -355,24 +358,43 @@ func runBuildCommand(ctx context.Context, flags *BuildFlags) error {
 		waitCtx, waitCancel := context.WithTimeout(ctx, waitTimeout)
 		defer waitCancel()
 
-		// Create callback to update results file on each poll
-		var callback maestro.WaitCallback
-		if flags.ResultsPath != "" || os.Getenv("RESULTS_PATH") != "" {
-			callback = func(details *maestro.ManifestWorkDetails, conditionMet bool) error {
-				status := "Waiting"
-				message := fmt.Sprintf("Waiting for condition '%s'", flags.Wait)
-				if conditionMet {
-					status = flags.Wait
-					message = fmt.Sprintf("Condition '%s' met", flags.Wait)
-				}
-				result := manifestwork.BuildStatusResult(existing.Name, flags.Consumer, status, message, details)
-				return manifestwork.WriteResult(flags.ResultsPath, result)
+		// Update the results file on each poll
+		writeResults := flags.ResultsPath != "" || os.Getenv("RESULTS_PATH") != ""
+		var lastDetails *maestro.ManifestWorkDetails
+		callback := func(details *maestro.ManifestWorkDetails, conditionMet bool) error {
+			lastDetails = details
+			if !writeResults {
+				return nil
 			}
+			status := "Waiting"
+			message := fmt.Sprintf("Waiting for condition '%s'", waitFor)
+			if conditionMet {
+				status = waitFor
+				message = fmt.Sprintf("Condition '%s' met", waitFor)
+			}
+			waitResult := manifestwork.BuildStatusResult(existing.Name, flags.Consumer, status, message, details)
+			waitResult.Operation = result.Operation
+			waitResult.Changed = manifestwork.OperationChanged(result.Operation)
+			waitResult.Steps = steps
+			return manifestwork.WriteResult(flags.ResultsPath, waitResult)
 		}
 
-		if err := client.WaitForCondition(
-			waitCtx, flags.Consumer, existing.Name, flags.Wait, maestro.DefaultPollInterval, log, callback,
-		); err != nil {
+		waitOpts := maestro.WaitOptions{
+			PollInterval: maestro.DefaultPollInterval,
+			FailOn:       flags.FailOn,
+			StableFor:    flags.StableFor,
+		}
+		err := client.WaitForConditionWithOptions(
+			waitCtx, flags.Consumer, existing.Name, waitFor, waitOpts, log, callback,
+		)
+		if result.Operation == maestro.ApplyOperationRecreated || err != nil {
+			waitResult := newWaitResult(existing.Name, flags.Consumer, waitFor, result, steps, lastDetails, err)
+			printConditionTrace(waitResult)
+			if writeErr := manifestwork.WriteResult(flags.ResultsPath, waitResult); writeErr != nil && err == nil {
+				return fmt.Errorf("failed to write results file: %w", writeErr)
+			}
+		}
+		if err != nil {
 			return err
 		}
 	}
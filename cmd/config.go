@@ -0,0 +1,274 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/openshift-hyperfleet/maestro-cli/internal/config"
+	clierrors "github.com/openshift-hyperfleet/maestro-cli/pkg/errors"
+)
+
+// contextStringFields maps the global connection flags to the context fields they set
+var contextStringFields = map[string]func(*config.Context) *string{
+	"grpc-endpoint":          func(c *config.Context) *string { return &c.GRPCEndpoint },
+	"http-endpoint":          func(c *config.Context) *string { return &c.HTTPEndpoint },
+	"grpc-server-ca-file":    func(c *config.Context) *string { return &c.GRPCServerCAFile },
+	"grpc-client-cert-file":  func(c *config.Context) *string { return &c.GRPCClientCertFile },
+	"grpc-client-key-file":   func(c *config.Context) *string { return &c.GRPCClientKeyFile },
+	"grpc-broker-ca-file":    func(c *config.Context) *string { return &c.GRPCBrokerCAFile },
+	"grpc-client-token":      func(c *config.Context) *string { return &c.GRPCClientToken },
+	"grpc-client-token-file": func(c *config.Context) *string { return &c.GRPCClientTokenFile },
+	"http-ca-file":           func(c *config.Context) *string { return &c.HTTPCAFile },
+	"http-client-cert-file":  func(c *config.Context) *string { return &c.HTTPClientCertFile },
+	"http-client-key-file":   func(c *config.Context) *string { return &c.HTTPClientKeyFile },
+	"http-token":             func(c *config.Context) *string { return &c.HTTPToken },
+	"http-token-file":        func(c *config.Context) *string { return &c.HTTPTokenFile },
+	"source-id":              func(c *config.Context) *string { return &c.SourceID },
+	"consumer":               func(c *config.Context) *string { return &c.Consumer },
+}
+
+// NewConfigCommand creates the config command group
+func NewConfigCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Manage maestro-cli config contexts",
+		Long: `Manage the maestro-cli config file holding named contexts.
+
+A context stores the connection settings for one Maestro instance: endpoints,
+TLS material, tokens or token files, source ID and a default consumer.
+Commands use the current context (or --context) for any setting that is not
+given as a flag or environment variable.
+
+The config file defaults to ~/.config/maestro-cli/config.yaml and can be
+changed with --config or MAESTRO_CONFIG.
+
+Examples:
+  # Create a context for a staging instance and switch to it
+  maestro-cli config set-context staging --grpc-endpoint=maestro-grpc.staging:8090 \
+    --http-endpoint=https://maestro.staging --grpc-client-token-file=/var/run/token \
+    --consumer=cluster-west-1
+  maestro-cli config use-context staging
+
+  # List contexts
+  maestro-cli config get-contexts
+
+  # Use another context for a single command
+  maestro-cli list --context=production`,
+	}
+
+	cmd.AddCommand(
+		newConfigGetContextsCommand(),
+		newConfigUseContextCommand(),
+		newConfigSetContextCommand(),
+		newConfigViewCommand(),
+	)
+
+	return cmd
+}
+
+// newConfigGetContextsCommand creates the config get-contexts command
+func newConfigGetContextsCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-contexts",
+		Short: "List the contexts in the config file",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := loadConfigFile(cmd)
+			if err != nil {
+				return err
+			}
+
+			// The table is the default; json/yaml only when requested explicitly
+			if cmd.Flags().Changed("output") {
+				return outputConfig(cfg.Redacted().Contexts, getStringFlag(cmd, "output"))
+			}
+
+			if len(cfg.Contexts) == 0 {
+				fmt.Printf("No contexts found in %s\n", getStringFlag(cmd, "config"))
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			_, _ = fmt.Fprintln(w, "CURRENT\tNAME\tGRPC ENDPOINT\tHTTP ENDPOINT\tCONSUMER")
+			for _, named := range cfg.Contexts {
+				current := ""
+				if named.Name == cfg.CurrentContext {
+					current = "*"
+				}
+				_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", current, named.Name,
+					named.Context.GRPCEndpoint, named.Context.HTTPEndpoint, named.Context.Consumer)
+			}
+			return w.Flush()
+		},
+	}
+}
+
+// newConfigUseContextCommand creates the config use-context command
+func newConfigUseContextCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "use-context NAME",
+		Short: "Set the current context",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadConfigFile(cmd)
+			if err != nil {
+				return err
+			}
+
+			name := args[0]
+			if _, ok := cfg.Context(name); !ok {
+				return clierrors.NewNotFound("context %q not found in %s", name, getStringFlag(cmd, "config"))
+			}
+
+			cfg.CurrentContext = name
+			if err := cfg.Save(getStringFlag(cmd, "config")); err != nil {
+				return err
+			}
+
+			fmt.Printf("Switched to context %q.\n", name)
+			return nil
+		},
+	}
+}
+
+// newConfigSetContextCommand creates the config set-context command
+func newConfigSetContextCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-context NAME",
+		Short: "Create or update a context",
+		Long: `Create a context, or update an existing one, from the connection flags.
+
+Only the flags given on the command line are stored; environment variables are
+not copied into the context. Fields of an existing context that are not given
+are kept unchanged.
+
+Examples:
+  # Create a context
+  maestro-cli config set-context local --grpc-endpoint=localhost:8090 \
+    --http-endpoint=http://localhost:8000 --grpc-insecure --consumer=cluster1
+
+  # Change the default consumer of an existing context
+  maestro-cli config set-context local --consumer=cluster2`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadConfigFile(cmd)
+			if err != nil {
+				return err
+			}
+
+			name := args[0]
+			ctx := config.Context{}
+			if existing, ok := cfg.Context(name); ok {
+				ctx = *existing
+			}
+
+			for flagName, field := range contextStringFields {
+				if cmd.Flags().Changed(flagName) {
+					*field(&ctx) = getStringFlag(cmd, flagName)
+				}
+			}
+			if cmd.Flags().Changed("grpc-insecure") {
+				ctx.GRPCInsecure = getBoolFlag(cmd, "grpc-insecure")
+			}
+
+			created := cfg.SetContext(name, ctx)
+			if err := cfg.Save(getStringFlag(cmd, "config")); err != nil {
+				return err
+			}
+
+			if created {
+				fmt.Printf("Context %q created.\n", name)
+			} else {
+				fmt.Printf("Context %q modified.\n", name)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String("consumer", "", "Default target cluster name for commands run in this context")
+
+	return cmd
+}
+
+// newConfigViewCommand creates the config view command
+func newConfigViewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "view",
+		Short: "Display the config file",
+		Long: `Display the config file. Inline tokens are redacted unless --raw is set.
+
+Examples:
+  # Show the config
+  maestro-cli config view
+
+  # Show only the context that commands would use
+  maestro-cli config view --minify`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := loadConfigFile(cmd)
+			if err != nil {
+				return err
+			}
+
+			if getBoolFlag(cmd, "minify") {
+				name := getStringFlag(cmd, "context")
+				if name == "" {
+					name = cfg.CurrentContext
+				}
+				ctx, err := cfg.Resolve(name)
+				if err != nil {
+					return clierrors.Wrap(clierrors.TypeNotFound, err)
+				}
+				minified := &config.Config{CurrentContext: name}
+				if ctx != nil {
+					minified.Contexts = []config.NamedContext{{Name: name, Context: *ctx}}
+				}
+				cfg = minified
+			}
+
+			if !getBoolFlag(cmd, "raw") {
+				cfg = cfg.Redacted()
+			}
+			return outputConfig(cfg, getStringFlag(cmd, "output"))
+		},
+	}
+
+	cmd.Flags().Bool("raw", false, "Show tokens instead of redacting them")
+	cmd.Flags().Bool("minify", false, "Only show the context selected by --context or the current context")
+
+	return cmd
+}
+
+// loadConfigFile loads the config file selected by --config
+func loadConfigFile(cmd *cobra.Command) (*config.Config, error) {
+	cfg, err := config.Load(getStringFlag(cmd, "config"))
+	if err != nil {
+		return nil, clierrors.Wrap(clierrors.TypeValidationFailed, err)
+	}
+	return cfg, nil
+}
+
+// outputConfig prints config content in JSON or YAML format
+func outputConfig(v interface{}, format string) error {
+	if strings.ToLower(format) == defaultOutputFormatJSON {
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to marshal YAML: %w", err)
+	}
+	fmt.Print(string(data))
+	return nil
+}
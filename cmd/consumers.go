@@ -0,0 +1,526 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
+
+	"github.com/openshift-hyperfleet/maestro-cli/internal/maestro"
+	clierrors "github.com/openshift-hyperfleet/maestro-cli/pkg/errors"
+	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
+)
+
+// ConsumersFlags contains flags for the consumers commands
+type ConsumersFlags struct {
+	Name      string            // Consumer name (get, create, label, delete)
+	Labels    map[string]string // Labels to set on create
+	Selector  string            // Label selector for list
+	Overwrite bool              // Allow label to change existing values
+	GlobalFlags
+}
+
+// ConsumerInfo is a consumer with the number of ManifestWorks it holds
+type ConsumerInfo struct {
+	maestro.ConsumerSummary
+	ManifestWorks int `json:"manifestWorks"`
+}
+
+// NewConsumersCommand creates the consumers command group
+func NewConsumersCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "consumers",
+		Aliases: []string{"consumer"},
+		Short:   "Manage Maestro consumers (target clusters)",
+		Long: `Manage the consumers registered with Maestro using the HTTP API.
+
+A consumer represents a target cluster; ManifestWorks are applied to consumers.
+
+Examples:
+  # List consumers with their labels and number of ManifestWorks
+  maestro-cli consumers list
+
+  # Register a cluster
+  maestro-cli consumers create cluster-west-1 --labels=env=prod,region=us-west
+
+  # Change labels (key- removes a label)
+  maestro-cli consumers label cluster-west-1 env=staging --overwrite
+  maestro-cli consumers label cluster-west-1 region-
+
+  # Delete a consumer that no longer has ManifestWorks
+  maestro-cli consumers delete cluster-west-1`,
+	}
+
+	cmd.AddCommand(
+		newConsumersListCommand(),
+		newConsumersGetCommand(),
+		newConsumersCreateCommand(),
+		newConsumersLabelCommand(),
+		newConsumersDeleteCommand(),
+	)
+
+	return cmd
+}
+
+// newConsumersListCommand creates the consumers list command
+func newConsumersListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List consumers",
+		Long: `List consumers with their labels, creation and update times and number of ManifestWorks.
+
+Output is a table unless --output=json or --output=yaml is given.
+
+Examples:
+  # List all consumers
+  maestro-cli consumers list
+
+  # List production consumers as JSON
+  maestro-cli consumers list --selector=env=prod --output=json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			globals, err := loadGlobalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			flags := &ConsumersFlags{
+				Selector:    getStringFlag(cmd, "selector"),
+				GlobalFlags: globals,
+			}
+			format := ""
+			if cmd.Flags().Changed("output") {
+				format = flags.Output
+			}
+
+			return runConsumersListCommand(cmd.Context(), flags, format)
+		},
+	}
+
+	cmd.Flags().StringP("selector", "l", "", "Label selector to filter consumers (e.g. 'env=prod,region in (east,west)')")
+
+	return cmd
+}
+
+// newConsumersGetCommand creates the consumers get command
+func newConsumersGetCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "get NAME",
+		Short: "Get a consumer",
+		Long: `Get a consumer with its labels and number of ManifestWorks.
+
+Examples:
+  # Get a consumer as YAML
+  maestro-cli consumers get cluster-west-1
+
+  # Get a consumer as JSON
+  maestro-cli consumers get cluster-west-1 --output=json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			globals, err := loadGlobalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			flags := &ConsumersFlags{Name: args[0], GlobalFlags: globals}
+			return runConsumersGetCommand(cmd.Context(), flags)
+		},
+	}
+}
+
+// newConsumersCreateCommand creates the consumers create command
+func newConsumersCreateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create NAME",
+		Short: "Register a consumer",
+		Long: `Register a new consumer (target cluster) with Maestro.
+
+Examples:
+  # Register a cluster
+  maestro-cli consumers create cluster-west-1
+
+  # Register a cluster with labels
+  maestro-cli consumers create cluster-west-1 --labels=env=prod,region=us-west`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			globals, err := loadGlobalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			labels, _ := cmd.Flags().GetStringToString("labels")
+			flags := &ConsumersFlags{
+				Name:        args[0],
+				Labels:      labels,
+				GlobalFlags: globals,
+			}
+
+			return runConsumersCreateCommand(cmd.Context(), flags)
+		},
+	}
+
+	cmd.Flags().StringToString("labels", nil, "Labels of the consumer (e.g. env=prod,region=us-west)")
+
+	return cmd
+}
+
+// newConsumersLabelCommand creates the consumers label command
+func newConsumersLabelCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "label NAME KEY=VALUE ... [KEY-]",
+		Short: "Add, update or remove consumer labels",
+		Long: `Add, update or remove labels of a consumer, like kubectl label.
+
+KEY=VALUE sets a label and KEY- removes it. Changing the value of an existing
+label requires --overwrite.
+
+Examples:
+  # Add a label
+  maestro-cli consumers label cluster-west-1 tier=gold
+
+  # Change an existing label
+  maestro-cli consumers label cluster-west-1 env=staging --overwrite
+
+  # Remove a label
+  maestro-cli consumers label cluster-west-1 tier-`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			globals, err := loadGlobalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			flags := &ConsumersFlags{
+				Name:        args[0],
+				Overwrite:   getBoolFlag(cmd, "overwrite"),
+				GlobalFlags: globals,
+			}
+
+			return runConsumersLabelCommand(cmd.Context(), flags, args[1:])
+		},
+	}
+
+	cmd.Flags().Bool("overwrite", false, "Allow changing the value of existing labels")
+
+	return cmd
+}
+
+// newConsumersDeleteCommand creates the consumers delete command
+func newConsumersDeleteCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete NAME",
+		Short: "Delete a consumer",
+		Long: `Delete a consumer from Maestro.
+
+The consumer must not hold any ManifestWorks: delete them first with
+'maestro-cli delete' so no resources are left orphaned on the cluster.
+
+Examples:
+  # Delete a consumer
+  maestro-cli consumers delete cluster-west-1`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			globals, err := loadGlobalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			flags := &ConsumersFlags{Name: args[0], GlobalFlags: globals}
+			return runConsumersDeleteCommand(cmd.Context(), flags)
+		},
+	}
+}
+
+// runConsumersListCommand executes the consumers list command
+func runConsumersListCommand(ctx context.Context, flags *ConsumersFlags, format string) error {
+	ctx, client, cleanup, err := newConsumersClient(ctx, flags)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	selector, err := labels.Parse(flags.Selector)
+	if err != nil {
+		return clierrors.NewValidationFailed("invalid selector %q: %w", flags.Selector, err)
+	}
+
+	consumers, err := client.ListConsumerSummariesHTTP(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list consumers: %w", err)
+	}
+
+	infos := []ConsumerInfo{}
+	for _, consumer := range consumers {
+		if !selector.Matches(labels.Set(consumer.Labels)) {
+			continue
+		}
+		info, err := consumerInfo(ctx, client, consumer)
+		if err != nil {
+			return err
+		}
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+
+	switch strings.ToLower(format) {
+	case defaultOutputFormatJSON, defaultOutputFormatYAML:
+		return outputConsumers(infos, format)
+	}
+
+	if len(infos) == 0 {
+		if flags.Selector != "" {
+			fmt.Printf("No consumers match selector '%s'\n", flags.Selector)
+		} else {
+			fmt.Println("No consumers found")
+		}
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAME\tLABELS\tWORKS\tCREATED\tUPDATED")
+	for _, info := range infos {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n",
+			info.Name, formatLabels(info.Labels), info.ManifestWorks, info.CreatedAt, info.UpdatedAt)
+	}
+	return w.Flush()
+}
+
+// runConsumersGetCommand executes the consumers get command
+func runConsumersGetCommand(ctx context.Context, flags *ConsumersFlags) error {
+	ctx, client, cleanup, err := newConsumersClient(ctx, flags)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	consumer, err := client.GetConsumerHTTP(ctx, flags.Name)
+	if err != nil {
+		return err
+	}
+	info, err := consumerInfo(ctx, client, *consumer)
+	if err != nil {
+		return err
+	}
+
+	return outputConsumers(info, flags.Output)
+}
+
+// runConsumersCreateCommand executes the consumers create command
+func runConsumersCreateCommand(ctx context.Context, flags *ConsumersFlags) error {
+	if err := validateConsumerLabels(flags.Labels); err != nil {
+		return err
+	}
+
+	ctx, client, cleanup, err := newConsumersClient(ctx, flags)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	if _, err := client.GetConsumerHTTP(ctx, flags.Name); err == nil {
+		return clierrors.New(clierrors.TypeConflict, "consumer %q already exists", flags.Name)
+	} else if clierrors.FromError(err).Type != clierrors.TypeNotFound {
+		return err
+	}
+
+	consumer, err := client.CreateConsumerHTTP(ctx, flags.Name, flags.Labels)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Consumer %q created (id: %s)\n", consumer.Name, consumer.ID)
+	return nil
+}
+
+// runConsumersLabelCommand executes the consumers label command
+func runConsumersLabelCommand(ctx context.Context, flags *ConsumersFlags, args []string) error {
+	set, remove, err := parseLabelArgs(args)
+	if err != nil {
+		return err
+	}
+
+	ctx, client, cleanup, err := newConsumersClient(ctx, flags)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	consumer, err := client.GetConsumerHTTP(ctx, flags.Name)
+	if err != nil {
+		return err
+	}
+
+	updated := make(map[string]string, len(consumer.Labels)+len(set))
+	for key, value := range consumer.Labels {
+		updated[key] = value
+	}
+	for key, value := range set {
+		if current, ok := updated[key]; ok && current != value && !flags.Overwrite {
+			return clierrors.New(clierrors.TypeConflict,
+				"consumer %q already has label %s=%s; use --overwrite to change it", flags.Name, key, current)
+		}
+		updated[key] = value
+	}
+	for _, key := range remove {
+		delete(updated, key)
+	}
+
+	if _, err := client.SetConsumerLabelsHTTP(ctx, flags.Name, updated); err != nil {
+		return err
+	}
+
+	fmt.Printf("Consumer %q labeled\n", flags.Name)
+	return nil
+}
+
+// runConsumersDeleteCommand executes the consumers delete command
+func runConsumersDeleteCommand(ctx context.Context, flags *ConsumersFlags) error {
+	ctx, client, cleanup, err := newConsumersClient(ctx, flags)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	if _, err := client.GetConsumerHTTP(ctx, flags.Name); err != nil {
+		return err
+	}
+
+	// Refuse to delete a consumer that still holds ManifestWorks
+	works, err := client.CountManifestWorksHTTP(ctx, flags.Name)
+	if err != nil {
+		return err
+	}
+	if works > 0 {
+		return clierrors.New(clierrors.TypeConflict,
+			"consumer %q still has %d ManifestWork(s); delete them first (see 'maestro-cli list --consumer=%s')",
+			flags.Name, works, flags.Name)
+	}
+
+	if err := client.DeleteConsumerHTTP(ctx, flags.Name); err != nil {
+		return err
+	}
+
+	fmt.Printf("Consumer %q deleted\n", flags.Name)
+	return nil
+}
+
+// newConsumersClient creates the HTTP client used by the consumers commands
+// The returned context carries the --timeout deadline; cleanup releases both.
+func newConsumersClient(
+	ctx context.Context,
+	flags *ConsumersFlags,
+) (context.Context, *maestro.Client, func(), error) {
+	log := logger.New(logger.Config{Level: getLogLevel(flags.Verbose), Format: "text"})
+
+	cancel := context.CancelFunc(func() {})
+	if flags.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, flags.Timeout)
+	}
+
+	client, err := maestro.NewHTTPClient(flags.clientConfig())
+	if err != nil {
+		cancel()
+		return nil, nil, nil, fmt.Errorf("failed to create Maestro client: %w", err)
+	}
+
+	cleanup := func() {
+		if err := client.Close(); err != nil {
+			log.Warn(ctx, "Failed to close client", logger.Fields{"error": err.Error()})
+		}
+		cancel()
+	}
+	return ctx, client, cleanup, nil
+}
+
+// consumerInfo adds the ManifestWork count to a consumer
+func consumerInfo(ctx context.Context, client *maestro.Client, consumer maestro.ConsumerSummary) (ConsumerInfo, error) {
+	works, err := client.CountManifestWorksHTTP(ctx, consumer.Name)
+	if err != nil {
+		return ConsumerInfo{}, fmt.Errorf("failed to count ManifestWorks of consumer %q: %w", consumer.Name, err)
+	}
+	return ConsumerInfo{ConsumerSummary: consumer, ManifestWorks: works}, nil
+}
+
+// parseLabelArgs parses KEY=VALUE and KEY- arguments into labels to set and keys to remove
+func parseLabelArgs(args []string) (map[string]string, []string, error) {
+	set := map[string]string{}
+	var remove []string
+	for _, arg := range args {
+		if key, ok := strings.CutSuffix(arg, "-"); ok && !strings.Contains(arg, "=") {
+			if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+				return nil, nil, clierrors.NewValidationFailed("invalid label key %q: %s", key, strings.Join(errs, "; "))
+			}
+			remove = append(remove, key)
+			continue
+		}
+
+		key, value, ok := strings.Cut(arg, "=")
+		if !ok {
+			return nil, nil, clierrors.NewValidationFailed("invalid label %q: expected KEY=VALUE or KEY-", arg)
+		}
+		set[key] = value
+	}
+
+	if err := validateConsumerLabels(set); err != nil {
+		return nil, nil, err
+	}
+	for _, key := range remove {
+		if _, ok := set[key]; ok {
+			return nil, nil, clierrors.NewValidationFailed("label %q is both set and removed", key)
+		}
+	}
+	return set, remove, nil
+}
+
+// validateConsumerLabels checks label keys and values with the Kubernetes label rules
+func validateConsumerLabels(consumerLabels map[string]string) error {
+	for key, value := range consumerLabels {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return clierrors.NewValidationFailed("invalid label key %q: %s", key, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return clierrors.NewValidationFailed("invalid value for label %q: %s", key, strings.Join(errs, "; "))
+		}
+	}
+	return nil
+}
+
+// formatLabels renders labels as sorted key=value pairs
+func formatLabels(consumerLabels map[string]string) string {
+	if len(consumerLabels) == 0 {
+		return "<none>"
+	}
+	pairs := make([]string, 0, len(consumerLabels))
+	for key, value := range consumerLabels {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// outputConsumers prints consumers in JSON or YAML format
+func outputConsumers(v interface{}, format string) error {
+	if strings.ToLower(format) == defaultOutputFormatJSON {
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to marshal YAML: %w", err)
+	}
+	fmt.Print(string(data))
+	return nil
+}
@@ -13,27 +13,21 @@ import (
 	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
 )
 
+// Per-consumer statuses reported by delete
+const (
+	statusDeleted  = "Deleted"
+	statusNotFound = "NotFound"
+	statusDryRun   = "DryRun"
+)
+
 // DeleteFlags contains flags for the delete command
 type DeleteFlags struct {
 	Name     string // Original ManifestWork name (metadata.name)
 	Consumer string
 	Wait     bool // Wait for deletion completion
 	DryRun   bool
-	// Global flags
-	GRPCEndpoint        string
-	HTTPEndpoint        string
-	GRPCInsecure        bool
-	GRPCServerCAFile    string
-	GRPCClientCertFile  string
-	GRPCClientKeyFile   string
-	GRPCBrokerCAFile    string
-	GRPCClientToken     string
-	GRPCClientTokenFile string
-	SourceID            string
-	ResultsPath         string
-	Output              string
-	Timeout             time.Duration
-	Verbose             bool
+	FanOut   FanOutFlags
+	GlobalFlags
 }
 
 // NewDeleteCommand creates the delete command
@@ -58,28 +52,28 @@ Examples:
   maestro-cli delete --name=my-manifestwork --consumer=cluster-west-1 --wait
 
   # Dry run to see what would be deleted
-  maestro-cli delete --name=nginx-work --consumer=cluster-west-1 --dry-run`,
+  maestro-cli delete --name=nginx-work --consumer=cluster-west-1 --dry-run
+
+  # Delete from every cluster labelled env=staging and wait for completion
+  maestro-cli delete --name=nginx-work --consumer-selector=env=staging --wait`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			globals, err := loadGlobalFlags(cmd)
+			if err != nil {
+				return err
+			}
+			fanOut := getFanOutFlags(cmd)
+			consumer, err := resolveTargetConsumer(cmd, &globals, &fanOut)
+			if err != nil {
+				return err
+			}
+
 			flags := &DeleteFlags{
-				Name:     getStringFlag(cmd, "name"),
-				Consumer: getStringFlag(cmd, "consumer"),
-				Wait:     getBoolFlag(cmd, "wait"),
-				DryRun:   getBoolFlag(cmd, "dry-run"),
-				// Global flags
-				GRPCEndpoint:        getStringFlag(cmd, "grpc-endpoint"),
-				HTTPEndpoint:        getStringFlag(cmd, "http-endpoint"),
-				GRPCInsecure:        getBoolFlag(cmd, "grpc-insecure"),
-				GRPCServerCAFile:    getStringFlag(cmd, "grpc-server-ca-file"),
-				GRPCClientCertFile:  getStringFlag(cmd, "grpc-client-cert-file"),
-				GRPCClientKeyFile:   getStringFlag(cmd, "grpc-client-key-file"),
-				GRPCBrokerCAFile:    getStringFlag(cmd, "grpc-broker-ca-file"),
-				GRPCClientToken:     getStringFlag(cmd, "grpc-client-token"),
-				GRPCClientTokenFile: getStringFlag(cmd, "grpc-client-token-file"),
-				SourceID:            getStringFlag(cmd, "source-id"),
-				ResultsPath:         getStringFlag(cmd, "results-path"),
-				Output:              getStringFlag(cmd, "output"),
-				Timeout:             getDurationFlag(cmd, "timeout"),
-				Verbose:             getBoolFlag(cmd, "verbose"),
+				Name:        getStringFlag(cmd, "name"),
+				Consumer:    consumer,
+				Wait:        getBoolFlag(cmd, "wait"),
+				DryRun:      getBoolFlag(cmd, "dry-run"),
+				FanOut:      fanOut,
+				GlobalFlags: globals,
 			}
 
 			return runDeleteCommand(cmd.Context(), flags)
@@ -88,17 +82,15 @@ Examples:
 
 	// Command-specific flags
 	cmd.Flags().String("name", "", "ManifestWork name (original metadata.name from your ManifestWork file)")
-	cmd.Flags().String("consumer", "", "Target cluster name (required)")
+	cmd.Flags().String("consumer", "", "Target cluster name (defaults to the consumer of the config context)")
 	cmd.Flags().Bool("wait", false, "Wait for deletion completion (like kubectl wait --for=delete)")
 	cmd.Flags().Bool("dry-run", false, "Show what would be deleted without making changes")
+	addFanOutFlags(cmd)
 
 	// Mark required flags
 	if err := cmd.MarkFlagRequired("name"); err != nil {
 		panic(err)
 	}
-	if err := cmd.MarkFlagRequired("consumer"); err != nil {
-		panic(err)
-	}
 
 	return cmd
 }
@@ -116,10 +108,7 @@ func runDeleteCommand(ctx context.Context, flags *DeleteFlags) error {
 	log := logger.New(logger.Config{Level: getLogLevel(flags.Verbose), Format: "text"})
 
 	// Create HTTP-only client (no gRPC needed for delete)
-	client, err := maestro.NewHTTPClient(maestro.ClientConfig{
-		HTTPEndpoint: flags.HTTPEndpoint,
-		GRPCInsecure: flags.GRPCInsecure,
-	})
+	client, err := maestro.NewHTTPClient(flags.clientConfig())
 	if err != nil {
 		return fmt.Errorf("failed to create Maestro client: %w", err)
 	}
@@ -129,30 +118,63 @@ func runDeleteCommand(ctx context.Context, flags *DeleteFlags) error {
 		}
 	}()
 
+	if flags.FanOut.enabled() {
+		consumers, err := resolveFanOutConsumers(ctx, client, &flags.FanOut)
+		if err != nil {
+			return err
+		}
+		results, errs := runFanOut(ctx, consumers, flags.Name, &flags.FanOut, log,
+			func(ctx context.Context, consumer string) (manifestwork.StatusResult, error) {
+				return deleteManifestWork(ctx, client, flags, consumer, log)
+			})
+		return finishFanOut("delete", flags.Name, results, errs, &flags.FanOut, flags.ResultsPath)
+	}
+
 	// Validate consumer exists
 	if err := client.ValidateConsumer(ctx, flags.Consumer); err != nil {
 		return err
 	}
 
 	// Handle ManifestWork deletion
-	return deleteManifestWork(ctx, client, flags, log)
+	result, err := deleteManifestWork(ctx, client, flags, flags.Consumer, log)
+	if err != nil || result.Status != statusDeleted {
+		return err
+	}
+
+	// Write result for status-reporter integration
+	return manifestwork.WriteResult(flags.ResultsPath, result)
 }
 
-// deleteManifestWork deletes an entire ManifestWork
-func deleteManifestWork(ctx context.Context, client *maestro.Client, flags *DeleteFlags, log *logger.Logger) error {
+// deleteManifestWork deletes an entire ManifestWork from the consumer
+// A missing ManifestWork is not an error and is reported with status NotFound.
+func deleteManifestWork(
+	ctx context.Context,
+	client *maestro.Client,
+	flags *DeleteFlags,
+	consumer string,
+	log *logger.Logger,
+) (manifestwork.StatusResult, error) {
+	result := manifestwork.StatusResult{
+		Name:      flags.Name,
+		Consumer:  consumer,
+		Timestamp: time.Now(),
+	}
+
 	// Check if the ManifestWork exists using HTTP API (doesn't require gRPC subscription)
-	work, err := client.GetManifestWorkByNameHTTP(ctx, flags.Consumer, flags.Name)
+	work, err := client.GetManifestWorkByNameHTTP(ctx, consumer, flags.Name)
 	if err != nil {
 		if errors.IsNotFound(err) {
 			// ManifestWork doesn't exist - just warn and exit successfully
 			log.Warn(ctx, "ManifestWork not found, nothing to delete", logger.Fields{
 				"name":     flags.Name,
-				"consumer": flags.Consumer,
+				"consumer": consumer,
 			})
-			return nil
+			result.Status = statusNotFound
+			result.Message = "ManifestWork not found, nothing to delete"
+			return result, nil
 		}
 		// Other error (network, auth, server) - return it
-		return fmt.Errorf("failed to check ManifestWork existence: %w", err)
+		return result, fmt.Errorf("failed to check ManifestWork existence: %w", err)
 	}
 
 	log.Debug(ctx, "Found ManifestWork", logger.Fields{
@@ -166,20 +188,22 @@ func deleteManifestWork(ctx context.Context, client *maestro.Client, flags *Dele
 	if flags.DryRun {
 		log.Info(ctx, "[DRY RUN] Would delete ManifestWork:", logger.Fields{
 			"name":            flags.Name,
-			"consumer":        flags.Consumer,
+			"consumer":        consumer,
 			"manifests_count": work.ManifestCount,
 		})
-		return nil
+		result.Status = statusDryRun
+		result.Message = fmt.Sprintf("Would delete ManifestWork with %d manifest(s)", work.ManifestCount)
+		return result, nil
 	}
 
 	// Delete the ManifestWork (using HTTP API - works regardless of source ID)
 	log.Info(ctx, "Deleting ManifestWork", logger.Fields{
 		"name":     flags.Name,
-		"consumer": flags.Consumer,
+		"consumer": consumer,
 	})
 
-	if err := client.DeleteManifestWorkByNameHTTP(ctx, flags.Consumer, flags.Name); err != nil {
-		return fmt.Errorf("failed to delete ManifestWork: %w", err)
+	if err := client.DeleteManifestWorkByNameHTTP(ctx, consumer, flags.Name); err != nil {
+		return result, fmt.Errorf("failed to delete ManifestWork: %w", err)
 	}
 
 	// Wait for deletion completion if requested (using HTTP polling, like kubectl wait --for=delete)
@@ -198,23 +222,18 @@ func deleteManifestWork(ctx context.Context, client *maestro.Client, flags *Dele
 		waitCtx, waitCancel := context.WithTimeout(ctx, waitTimeout)
 		defer waitCancel()
 
-		if err := client.WaitForDeletion(waitCtx, flags.Consumer, flags.Name, maestro.DefaultPollInterval, log); err != nil {
-			return fmt.Errorf("error waiting for deletion: %w", err)
+		if err := client.WaitForDeletion(waitCtx, consumer, flags.Name, maestro.DefaultPollInterval, log); err != nil {
+			return result, fmt.Errorf("error waiting for deletion: %w", err)
 		}
 	}
 
 	log.Info(ctx, "Successfully deleted ManifestWork", logger.Fields{
 		"name":     flags.Name,
-		"consumer": flags.Consumer,
+		"consumer": consumer,
 	})
 
-	// Write result for status-reporter integration
-	result := manifestwork.StatusResult{
-		Name:      flags.Name,
-		Consumer:  flags.Consumer,
-		Status:    "Deleted",
-		Message:   "ManifestWork deleted successfully",
-		Timestamp: time.Now(),
-	}
-	return manifestwork.WriteResult(flags.ResultsPath, result)
+	result.Status = statusDeleted
+	result.Message = "ManifestWork deleted successfully"
+	result.Timestamp = time.Now()
+	return result, nil
 }
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/spf13/cobra"
 	"sigs.k8s.io/yaml"
@@ -18,20 +17,7 @@ import (
 type DescribeFlags struct {
 	Name     string
 	Consumer string
-	// Global flags
-	GRPCEndpoint        string
-	HTTPEndpoint        string
-	GRPCInsecure        bool
-	GRPCServerCAFile    string
-	GRPCClientCertFile  string
-	GRPCClientKeyFile   string
-	GRPCBrokerCAFile    string
-	GRPCClientToken     string
-	GRPCClientTokenFile string
-	ResultsPath         string
-	Output              string
-	Timeout             time.Duration
-	Verbose             bool
+	GlobalFlags
 }
 
 // NewDescribeCommand creates the describe command
@@ -48,23 +34,19 @@ Examples:
   # Describe with JSON output
   maestro-cli describe --name=hyperfleet-cluster-west-1-nodepool --consumer=cluster-west-1 --output=json`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			globals, err := loadGlobalFlags(cmd)
+			if err != nil {
+				return err
+			}
+			consumer, err := globals.consumer(cmd)
+			if err != nil {
+				return err
+			}
+
 			flags := &DescribeFlags{
-				Name:     getStringFlag(cmd, "name"),
-				Consumer: getStringFlag(cmd, "consumer"),
-				// Global flags
-				GRPCEndpoint:        getStringFlag(cmd, "grpc-endpoint"),
-				HTTPEndpoint:        getStringFlag(cmd, "http-endpoint"),
-				GRPCInsecure:        getBoolFlag(cmd, "grpc-insecure"),
-				GRPCServerCAFile:    getStringFlag(cmd, "grpc-server-ca-file"),
-				GRPCClientCertFile:  getStringFlag(cmd, "grpc-client-cert-file"),
-				GRPCClientKeyFile:   getStringFlag(cmd, "grpc-client-key-file"),
-				GRPCBrokerCAFile:    getStringFlag(cmd, "grpc-broker-ca-file"),
-				GRPCClientToken:     getStringFlag(cmd, "grpc-client-token"),
-				GRPCClientTokenFile: getStringFlag(cmd, "grpc-client-token-file"),
-				ResultsPath:         getStringFlag(cmd, "results-path"),
-				Output:              getStringFlag(cmd, "output"),
-				Timeout:             getDurationFlag(cmd, "timeout"),
-				Verbose:             getBoolFlag(cmd, "verbose"),
+				Name:        getStringFlag(cmd, "name"),
+				Consumer:    consumer,
+				GlobalFlags: globals,
 			}
 
 			return runDescribeCommand(cmd.Context(), flags)
@@ -73,15 +55,12 @@ Examples:
 
 	// Command-specific flags
 	cmd.Flags().String("name", "", "ManifestWork name (required)")
-	cmd.Flags().String("consumer", "", "Target cluster name (required)")
+	cmd.Flags().String("consumer", "", "Target cluster name (defaults to the consumer of the config context)")
 
 	// Mark required flags
 	if err := cmd.MarkFlagRequired("name"); err != nil {
 		panic(err)
 	}
-	if err := cmd.MarkFlagRequired("consumer"); err != nil {
-		panic(err)
-	}
 
 	return cmd
 }
@@ -102,10 +81,7 @@ func runDescribeCommand(ctx context.Context, flags *DescribeFlags) error {
 	})
 
 	// Create HTTP-only client (no gRPC needed for describe)
-	client, err := maestro.NewHTTPClient(maestro.ClientConfig{
-		HTTPEndpoint: flags.HTTPEndpoint,
-		GRPCInsecure: flags.GRPCInsecure,
-	})
+	client, err := maestro.NewHTTPClient(flags.clientConfig())
 	if err != nil {
 		return fmt.Errorf("failed to create Maestro client: %w", err)
 	}
@@ -7,7 +7,6 @@ import (
 	"reflect"
 	"sort"
 	"strings"
-	"time"
 
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -15,6 +14,7 @@ import (
 
 	"github.com/openshift-hyperfleet/maestro-cli/internal/maestro"
 	"github.com/openshift-hyperfleet/maestro-cli/internal/manifestwork"
+	clierrors "github.com/openshift-hyperfleet/maestro-cli/pkg/errors"
 	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
 )
 
@@ -22,20 +22,7 @@ import (
 type DiffFlags struct {
 	ManifestFile string
 	Consumer     string
-	// Global flags
-	GRPCEndpoint        string
-	HTTPEndpoint        string
-	GRPCInsecure        bool
-	GRPCServerCAFile    string
-	GRPCClientCertFile  string
-	GRPCClientKeyFile   string
-	GRPCBrokerCAFile    string
-	GRPCClientToken     string
-	GRPCClientTokenFile string
-	ResultsPath         string
-	Output              string
-	Timeout             time.Duration
-	Verbose             bool
+	GlobalFlags
 }
 
 // NewDiffCommand creates the diff command
@@ -52,23 +39,19 @@ Examples:
   # Show differences with verbose output
   maestro-cli diff --manifest-file=job-manifestwork.json --consumer=agent1 --verbose`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			globals, err := loadGlobalFlags(cmd)
+			if err != nil {
+				return err
+			}
+			consumer, err := globals.consumer(cmd)
+			if err != nil {
+				return err
+			}
+
 			flags := &DiffFlags{
 				ManifestFile: getStringFlag(cmd, "manifest-file"),
-				Consumer:     getStringFlag(cmd, "consumer"),
-				// Global flags
-				GRPCEndpoint:        getStringFlag(cmd, "grpc-endpoint"),
-				HTTPEndpoint:        getStringFlag(cmd, "http-endpoint"),
-				GRPCInsecure:        getBoolFlag(cmd, "grpc-insecure"),
-				GRPCServerCAFile:    getStringFlag(cmd, "grpc-server-ca-file"),
-				GRPCClientCertFile:  getStringFlag(cmd, "grpc-client-cert-file"),
-				GRPCClientKeyFile:   getStringFlag(cmd, "grpc-client-key-file"),
-				GRPCBrokerCAFile:    getStringFlag(cmd, "grpc-broker-ca-file"),
-				GRPCClientToken:     getStringFlag(cmd, "grpc-client-token"),
-				GRPCClientTokenFile: getStringFlag(cmd, "grpc-client-token-file"),
-				ResultsPath:         getStringFlag(cmd, "results-path"),
-				Output:              getStringFlag(cmd, "output"),
-				Timeout:             getDurationFlag(cmd, "timeout"),
-				Verbose:             getBoolFlag(cmd, "verbose"),
+				Consumer:     consumer,
+				GlobalFlags:  globals,
 			}
 
 			return runDiffCommand(cmd.Context(), flags)
@@ -77,15 +60,12 @@ Examples:
 
 	// Command-specific flags
 	cmd.Flags().String("manifest-file", "", "Path to ManifestWork YAML/JSON file (required)")
-	cmd.Flags().String("consumer", "", "Target cluster name (required)")
+	cmd.Flags().String("consumer", "", "Target cluster name (defaults to the consumer of the config context)")
 
 	// Mark required flags
 	if err := cmd.MarkFlagRequired("manifest-file"); err != nil {
 		panic(err)
 	}
-	if err := cmd.MarkFlagRequired("consumer"); err != nil {
-		panic(err)
-	}
 
 	return cmd
 }
@@ -110,14 +90,11 @@ func runDiffCommand(ctx context.Context, flags *DiffFlags) error {
 
 	localMW, err := manifestwork.LoadManifestWorkFromFile(flags.ManifestFile)
 	if err != nil {
-		return fmt.Errorf("failed to load local ManifestWork: %w", err)
+		return clierrors.NewValidationFailed("failed to load local ManifestWork: %w", err)
 	}
 
 	// Create HTTP-only client
-	client, err := maestro.NewHTTPClient(maestro.ClientConfig{
-		HTTPEndpoint: flags.HTTPEndpoint,
-		GRPCInsecure: flags.GRPCInsecure,
-	})
+	client, err := maestro.NewHTTPClient(flags.clientConfig())
 	if err != nil {
 		return fmt.Errorf("failed to create Maestro client: %w", err)
 	}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	clierrors "github.com/openshift-hyperfleet/maestro-cli/pkg/errors"
+)
+
+// errorOutput is the machine-readable error written to stderr with --output=json
+type errorOutput struct {
+	Error errorDetail `json:"error"`
+}
+
+type errorDetail struct {
+	Type     clierrors.Type `json:"type"`
+	Message  string         `json:"message"`
+	Code     string         `json:"code,omitempty"`
+	Reason   string         `json:"reason,omitempty"`
+	ExitCode int            `json:"exitCode"`
+}
+
+// HandleError reports a failed command on w and returns the process exit code for the error
+// With --output=json (or jsonl) the error is written as a JSON object instead of plain text
+func HandleError(root *cobra.Command, w io.Writer, err error) int {
+	cliErr := clierrors.FromError(err)
+	if cliErr == nil {
+		return clierrors.ExitSuccess
+	}
+
+	output, _ := root.PersistentFlags().GetString("output")
+	switch strings.ToLower(output) {
+	case defaultOutputFormatJSON, outputFormatJSONLines:
+		data, marshalErr := json.Marshal(errorOutput{Error: errorDetail{
+			Type:     cliErr.Type,
+			Message:  cliErr.Error(),
+			Code:     cliErr.Code,
+			Reason:   cliErr.Reason,
+			ExitCode: cliErr.ExitCode(),
+		}})
+		if marshalErr == nil {
+			_, _ = fmt.Fprintln(w, string(data))
+			break
+		}
+		_, _ = fmt.Fprintf(w, "Error: %v\n", err)
+	default:
+		_, _ = fmt.Fprintf(w, "Error: %v\n", err)
+	}
+
+	return cliErr.ExitCode()
+}
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	clierrors "github.com/openshift-hyperfleet/maestro-cli/pkg/errors"
+)
+
+func TestHandleError(t *testing.T) {
+	err := fmt.Errorf("failed to get ManifestWork: %w", clierrors.NewNotFound("ManifestWork test-mw not found"))
+
+	for _, output := range []string{defaultOutputFormatJSON, outputFormatJSONLines} {
+		t.Run(output, func(t *testing.T) {
+			root := NewRootCommand()
+			if err := root.PersistentFlags().Set("output", output); err != nil {
+				t.Fatalf("failed to set --output: %v", err)
+			}
+			var stderr bytes.Buffer
+			if code := HandleError(root, &stderr, err); code != clierrors.ExitNotFound {
+				t.Errorf("HandleError() = %d, expected %d", code, clierrors.ExitNotFound)
+			}
+
+			// The error is a single JSON object on one line
+			if lines := strings.Split(strings.TrimSuffix(stderr.String(), "\n"), "\n"); len(lines) != 1 {
+				t.Fatalf("expected one line, got %q", stderr.String())
+			}
+			var got errorOutput
+			if err := json.Unmarshal(stderr.Bytes(), &got); err != nil {
+				t.Fatalf("output is not JSON: %v (%q)", err, stderr.String())
+			}
+			expected := errorDetail{
+				Type:     clierrors.TypeNotFound,
+				Message:  "failed to get ManifestWork: ManifestWork test-mw not found",
+				ExitCode: clierrors.ExitNotFound,
+			}
+			if got.Error != expected {
+				t.Errorf("error = %+v, expected %+v", got.Error, expected)
+			}
+		})
+	}
+
+	t.Run("yaml", func(t *testing.T) {
+		var stderr bytes.Buffer
+		if code := HandleError(NewRootCommand(), &stderr, err); code != clierrors.ExitNotFound {
+			t.Errorf("HandleError() = %d, expected %d", code, clierrors.ExitNotFound)
+		}
+		if expected := "Error: failed to get ManifestWork: ManifestWork test-mw not found\n"; stderr.String() != expected {
+			t.Errorf("output = %q, expected %q", stderr.String(), expected)
+		}
+	})
+
+	var stderr bytes.Buffer
+	if code := HandleError(NewRootCommand(), &stderr, nil); code != clierrors.ExitSuccess || stderr.Len() != 0 {
+		t.Errorf("expected exit code 0 and no output without an error, got %d and %q", code, stderr.String())
+	}
+}
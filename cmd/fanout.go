@@ -0,0 +1,355 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/openshift-hyperfleet/maestro-cli/internal/maestro"
+	"github.com/openshift-hyperfleet/maestro-cli/internal/manifestwork"
+	clierrors "github.com/openshift-hyperfleet/maestro-cli/pkg/errors"
+	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
+)
+
+// DefaultParallelism is the default number of consumers processed concurrently by fan-out operations
+const DefaultParallelism = 10
+
+// FanOutFlags selects the consumers an operation runs against and how partial failure is handled
+type FanOutFlags struct {
+	Consumers    []string // Explicit list of consumers (--consumers)
+	AllConsumers bool     // Every registered consumer (--all-consumers)
+	Selector     string   // Label selector matched against consumer labels (--consumer-selector)
+	Parallelism  int      // Maximum number of consumers processed concurrently
+	FailFast     bool     // Cancel remaining consumers after the first failure
+	// SummaryFormat is the explicitly requested --output format for the summary; empty prints a table
+	SummaryFormat string
+}
+
+// fanOutTask runs an operation against a single consumer
+// The returned result is completed with the error message when the task fails.
+type fanOutTask func(ctx context.Context, consumer string) (manifestwork.StatusResult, error)
+
+// addFanOutFlags adds the flags that run a command against multiple consumers
+func addFanOutFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice("consumers", nil, "Comma-separated target cluster names to run against concurrently")
+	cmd.Flags().Bool("all-consumers", false, "Run against every consumer registered with Maestro")
+	cmd.Flags().String("consumer-selector", "",
+		"Run against consumers whose labels match the selector (e.g. 'env=prod,region in (us-east,us-west)')")
+	cmd.Flags().Int("parallelism", DefaultParallelism, "Maximum number of consumers processed concurrently")
+	cmd.Flags().Bool("fail-fast", false,
+		"Stop after the first consumer failure, cancelling in-flight consumers (default: continue with the rest)")
+}
+
+// getFanOutFlags reads the fan-out flags of the command
+func getFanOutFlags(cmd *cobra.Command) FanOutFlags {
+	consumers, _ := cmd.Flags().GetStringSlice("consumers")
+	flags := FanOutFlags{
+		Consumers:    consumers,
+		AllConsumers: getBoolFlag(cmd, "all-consumers"),
+		Selector:     getStringFlag(cmd, "consumer-selector"),
+		Parallelism:  getIntFlag(cmd, "parallelism"),
+		FailFast:     getBoolFlag(cmd, "fail-fast"),
+	}
+	if cmd.Flags().Changed("output") {
+		flags.SummaryFormat = getStringFlag(cmd, "output")
+	}
+	return flags
+}
+
+// enabled reports whether the command runs against multiple consumers
+func (f *FanOutFlags) enabled() bool {
+	return len(f.Consumers) > 0 || f.AllConsumers || f.Selector != ""
+}
+
+// resolveTargetConsumer returns the single consumer of the command, or "" when fan-out flags are used
+func resolveTargetConsumer(cmd *cobra.Command, globals *GlobalFlags, fanOut *FanOutFlags) (string, error) {
+	if !fanOut.enabled() {
+		return globals.consumer(cmd)
+	}
+
+	set := 0
+	for _, isSet := range []bool{len(fanOut.Consumers) > 0, fanOut.AllConsumers, fanOut.Selector != ""} {
+		if isSet {
+			set++
+		}
+	}
+	if set > 1 || cmd.Flags().Changed("consumer") {
+		return "", clierrors.NewValidationFailed(
+			"--consumer, --consumers, --all-consumers and --consumer-selector are mutually exclusive")
+	}
+	if fanOut.Parallelism <= 0 {
+		return "", clierrors.NewValidationFailed("--parallelism must be at least 1, got %d", fanOut.Parallelism)
+	}
+	return "", nil
+}
+
+// resolveFanOutConsumers returns the sorted consumers selected by the fan-out flags
+func resolveFanOutConsumers(ctx context.Context, client *maestro.Client, fanOut *FanOutFlags) ([]string, error) {
+	if len(fanOut.Consumers) == 0 {
+		consumers, err := client.SelectConsumers(ctx, fanOut.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("failed to select consumers: %w", err)
+		}
+		if len(consumers) == 0 {
+			if fanOut.Selector != "" {
+				return nil, clierrors.NewNotFound("no consumers match selector %q", fanOut.Selector)
+			}
+			return nil, clierrors.NewNotFound("no consumers are registered with Maestro")
+		}
+		return consumers, nil
+	}
+
+	registered, err := client.ListConsumers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate consumers: %w", err)
+	}
+	known := make(map[string]bool, len(registered))
+	for _, name := range registered {
+		known[name] = true
+	}
+
+	seen := make(map[string]bool, len(fanOut.Consumers))
+	var consumers, missing []string
+	for _, name := range fanOut.Consumers {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		if !known[name] {
+			missing = append(missing, name)
+			continue
+		}
+		consumers = append(consumers, name)
+	}
+	if len(missing) > 0 {
+		return nil, clierrors.NewNotFound("consumer(s) not found: %s", strings.Join(missing, ", "))
+	}
+	if len(consumers) == 0 {
+		return nil, clierrors.NewValidationFailed("--consumers does not name any consumer")
+	}
+
+	sort.Strings(consumers)
+	return consumers, nil
+}
+
+// runFanOut runs task for every consumer on a pool of at most parallelism workers and
+// returns the per-consumer results and errors in consumer order.
+// Without failFast every consumer is processed; with failFast the first failure cancels
+// in-flight consumers and the remaining ones are reported as skipped.
+func runFanOut(
+	ctx context.Context,
+	consumers []string,
+	name string,
+	fanOut *FanOutFlags,
+	log *logger.Logger,
+	task fanOutTask,
+) ([]manifestwork.StatusResult, []error) {
+	results := make([]manifestwork.StatusResult, len(consumers))
+	errs := make([]error, len(consumers))
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var failed atomic.Bool
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < min(fanOut.Parallelism, len(consumers)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				consumer := consumers[i]
+				consumerCtx := logger.ContextWithClusterID(runCtx, consumer)
+				result, err := task(consumerCtx, consumer)
+
+				switch {
+				case err == nil:
+				case fanOut.FailFast && failed.Load() && errors.Is(err, context.Canceled) && ctx.Err() == nil:
+					// Cancelled because another consumer failed
+					result = skippedResult(name, consumer, "cancelled after another consumer failed")
+					err = nil
+				default:
+					result = failedResult(result, name, consumer, err)
+					log.Error(consumerCtx, err, "Operation failed for consumer", logger.Fields{"consumer": consumer})
+					if fanOut.FailFast && failed.CompareAndSwap(false, true) {
+						cancel()
+					}
+				}
+				results[i], errs[i] = result, err
+			}
+		}()
+	}
+
+	for i, consumer := range consumers {
+		if runCtx.Err() != nil {
+			results[i] = skippedResult(name, consumer, skipReason(ctx))
+			continue
+		}
+		select {
+		case jobs <- i:
+		case <-runCtx.Done():
+			results[i] = skippedResult(name, consumer, skipReason(ctx))
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results, errs
+}
+
+// skipReason explains why consumers were not processed
+func skipReason(ctx context.Context) string {
+	if ctx.Err() != nil {
+		return fmt.Sprintf("not started: %v", ctx.Err())
+	}
+	return "not started after another consumer failed"
+}
+
+// skippedResult is the result of a consumer that was not processed
+func skippedResult(name, consumer, message string) manifestwork.StatusResult {
+	return manifestwork.StatusResult{
+		Name:      name,
+		Consumer:  consumer,
+		Status:    manifestwork.StatusSkipped,
+		Message:   message,
+		Timestamp: time.Now(),
+	}
+}
+
+// failedResult marks the result of a consumer as failed with the error message
+// A met --fail-on condition is recorded with the sub-expression that matched, and a condition
+// not met before the timeout with the trace of its evaluation.
+func failedResult(result manifestwork.StatusResult, name, consumer string, err error) manifestwork.StatusResult {
+	result.Name = name
+	result.Consumer = consumer
+	if !manifestwork.IsFailedStatus(result.Status) {
+		result.Status = manifestwork.StatusFailed // Keep RolledBack and RollbackFailed
+	}
+	result.Message = err.Error()
+	result.Timestamp = time.Now()
+	var failedErr *maestro.ConditionFailedError
+	if errors.As(err, &failedErr) {
+		result.FailedCondition = failedErr.Matched
+	}
+	var unmetErr *maestro.ConditionUnmetError
+	if errors.As(err, &unmetErr) {
+		result.Trace = unmetErr.Trace
+	}
+	return result
+}
+
+// finishFanOut writes the aggregated results file, prints the summary and returns an error
+// if any consumer failed or was skipped
+func finishFanOut(
+	operation, name string,
+	results []manifestwork.StatusResult,
+	errs []error,
+	fanOut *FanOutFlags,
+	resultsPath string,
+) error {
+	aggregate := manifestwork.NewAggregateResult(name, operation, results)
+
+	if err := manifestwork.WriteAggregateResult(resultsPath, aggregate); err != nil {
+		return fmt.Errorf("failed to write results file: %w", err)
+	}
+	if err := outputAggregateResult(aggregate, fanOut.SummaryFormat); err != nil {
+		return err
+	}
+	for _, result := range aggregate.Results {
+		printConditionTrace(result)
+	}
+
+	if aggregate.Status == manifestwork.AggregateStatusSucceeded {
+		return nil
+	}
+	return fanOutError(aggregate, errs)
+}
+
+// fanOutError summarizes the failed consumers in one error
+func fanOutError(aggregate manifestwork.AggregateResult, errs []error) error {
+	var failedConsumers []string
+	for i, err := range errs {
+		if err != nil {
+			failedConsumers = append(failedConsumers, aggregate.Results[i].Consumer)
+		}
+	}
+
+	message := aggregate.Message
+	if len(failedConsumers) > 0 {
+		message = fmt.Sprintf("%s (failed: %s)", message, strings.Join(failedConsumers, ", "))
+	}
+	return clierrors.New(commonErrorType(errs), "%s", message)
+}
+
+// commonErrorType returns the type shared by all non-nil errors, or General when they differ
+// Keeping the shared type keeps the exit code of a partial failure meaningful.
+func commonErrorType(errs []error) clierrors.Type {
+	errType := clierrors.Type("")
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		t := clierrors.FromError(err).Type
+		if errType == "" {
+			errType = t
+		} else if errType != t {
+			errType = clierrors.TypeGeneral
+		}
+	}
+	if errType == "" {
+		// Only skipped consumers, e.g. the parent context was cancelled
+		errType = clierrors.TypeGeneral
+	}
+	return errType
+}
+
+// outputAggregateResult prints the multi-consumer result as a table, or as JSON/YAML when requested
+func outputAggregateResult(aggregate manifestwork.AggregateResult, format string) error {
+	if handled, err := outputStructured(aggregate, format); handled {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprintln(w, "CONSUMER\tSTATUS\tMESSAGE")
+	for _, r := range aggregate.Results {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", r.Consumer, r.Status, r.Message)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	fmt.Printf("\n%s: %s\n", aggregate.Status, aggregate.Message)
+	return nil
+}
+
+// outputStructured prints v as JSON or YAML when format asks for it and reports whether it did
+func outputStructured(v interface{}, format string) (bool, error) {
+	switch strings.ToLower(format) {
+	case defaultOutputFormatJSON:
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return true, fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return true, nil
+	case defaultOutputFormatYAML:
+		data, err := yaml.Marshal(v)
+		if err != nil {
+			return true, fmt.Errorf("failed to marshal YAML: %w", err)
+		}
+		fmt.Print(string(data))
+		return true, nil
+	}
+	return false, nil
+}
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/spf13/cobra"
 	"sigs.k8s.io/yaml"
@@ -18,21 +17,8 @@ import (
 type ListFlags struct {
 	Consumer string
 	Filter   string // Filter by manifest content (kind, name, or kind/name)
-	// Global flags
-	GRPCEndpoint        string
-	HTTPEndpoint        string
-	GRPCInsecure        bool
-	GRPCServerCAFile    string
-	GRPCClientCertFile  string
-	GRPCClientKeyFile   string
-	GRPCBrokerCAFile    string
-	GRPCClientToken     string
-	GRPCClientTokenFile string
-	SourceID            string
-	ResultsPath         string
-	Output              string
-	Timeout             time.Duration
-	Verbose             bool
+	PageSize int32  // Number of ManifestWorks requested per page
+	GlobalFlags
 }
 
 // NewListCommand creates the list command
@@ -48,6 +34,10 @@ without requiring a gRPC connection.
 Note: Maestro generates UUIDs for ManifestWork names. Use --filter to find
 ManifestWorks by the resources they contain.
 
+ManifestWorks are fetched page by page (--page-size). Table and JSON-lines
+(--output=jsonl) output is written as each page arrives; json and yaml output
+is written once all pages have been fetched.
+
 Examples:
   # List all ManifestWorks for a cluster
   maestro-cli list --consumer=cluster-west-1
@@ -58,26 +48,25 @@ Examples:
   maestro-cli list --consumer=cluster-west-1 --filter=Deployment/nginx
 
   # List with JSON output
-  maestro-cli list --consumer=cluster-west-1 --output=json`,
+  maestro-cli list --consumer=cluster-west-1 --output=json
+
+  # Stream one JSON object per line for a consumer with many ManifestWorks
+  maestro-cli list --consumer=cluster-west-1 --output=jsonl --page-size=400`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			globals, err := loadGlobalFlags(cmd)
+			if err != nil {
+				return err
+			}
+			consumer, err := globals.consumer(cmd)
+			if err != nil {
+				return err
+			}
+
 			flags := &ListFlags{
-				Consumer: getStringFlag(cmd, "consumer"),
-				Filter:   getStringFlag(cmd, "filter"),
-				// Global flags
-				GRPCEndpoint:        getStringFlag(cmd, "grpc-endpoint"),
-				HTTPEndpoint:        getStringFlag(cmd, "http-endpoint"),
-				GRPCInsecure:        getBoolFlag(cmd, "grpc-insecure"),
-				GRPCServerCAFile:    getStringFlag(cmd, "grpc-server-ca-file"),
-				GRPCClientCertFile:  getStringFlag(cmd, "grpc-client-cert-file"),
-				GRPCClientKeyFile:   getStringFlag(cmd, "grpc-client-key-file"),
-				GRPCBrokerCAFile:    getStringFlag(cmd, "grpc-broker-ca-file"),
-				GRPCClientToken:     getStringFlag(cmd, "grpc-client-token"),
-				GRPCClientTokenFile: getStringFlag(cmd, "grpc-client-token-file"),
-				SourceID:            getStringFlag(cmd, "source-id"),
-				ResultsPath:         getStringFlag(cmd, "results-path"),
-				Output:              getStringFlag(cmd, "output"),
-				Timeout:             getDurationFlag(cmd, "timeout"),
-				Verbose:             getBoolFlag(cmd, "verbose"),
+				Consumer:    consumer,
+				Filter:      getStringFlag(cmd, "filter"),
+				PageSize:    getInt32Flag(cmd, "page-size"),
+				GlobalFlags: globals,
 			}
 
 			return runListCommand(cmd.Context(), flags)
@@ -85,15 +74,12 @@ Examples:
 	}
 
 	// Command-specific flags
-	cmd.Flags().String("consumer", "", "Target cluster name (required)")
+	cmd.Flags().String("consumer", "", "Target cluster name (defaults to the consumer of the config context)")
 	cmd.Flags().String(
 		"filter", "", "Filter by manifest content (e.g., 'nginx', 'Namespace/hyperfleet', 'Deployment/default/nginx')",
 	)
-
-	// Mark required flags
-	if err := cmd.MarkFlagRequired("consumer"); err != nil {
-		panic(err)
-	}
+	cmd.Flags().Int32("page-size", maestro.DefaultListPageSize,
+		fmt.Sprintf("Number of ManifestWorks requested per page (max %d)", maestro.MaxListPageSize))
 
 	return cmd
 }
@@ -114,10 +100,7 @@ func runListCommand(ctx context.Context, flags *ListFlags) error {
 	})
 
 	// Create HTTP-only client (no gRPC subscription needed for list)
-	client, err := maestro.NewHTTPClient(maestro.ClientConfig{
-		HTTPEndpoint: flags.HTTPEndpoint,
-		GRPCInsecure: flags.GRPCInsecure,
-	})
+	client, err := maestro.NewHTTPClient(flags.clientConfig())
 	if err != nil {
 		return fmt.Errorf("failed to create Maestro client: %w", err)
 	}
@@ -139,28 +122,49 @@ func runListCommand(ctx context.Context, flags *ListFlags) error {
 		"filter":        flags.Filter,
 	})
 
-	works, err := client.ListManifestWorksHTTP(ctx, flags.Consumer)
+	output := strings.ToLower(flags.Output)
+	buffered := output == defaultOutputFormatJSON || output == defaultOutputFormatYAML
+
+	// Table and JSON-lines output is streamed page by page; json and yaml need the full list
+	var works []maestro.ResourceBundleSummary
+	count := 0
+	err = client.ListManifestWorksPagesHTTP(ctx, flags.Consumer, flags.PageSize,
+		func(page []maestro.ResourceBundleSummary) error {
+			page = filterResourceBundles(page, flags.Filter)
+			log.Debug(ctx, "Received page", logger.Fields{
+				"filter":  flags.Filter,
+				"matched": len(page),
+			})
+
+			switch {
+			case buffered:
+				works = append(works, page...)
+			case output == outputFormatJSONLines:
+				if err := outputResourceBundlesJSONLines(page); err != nil {
+					return err
+				}
+			default:
+				for i, rb := range page {
+					outputResourceBundleTableEntry(rb, count+i == 0)
+				}
+			}
+			count += len(page)
+			return nil
+		})
 	if err != nil {
 		return fmt.Errorf("failed to list ManifestWorks: %w", err)
 	}
 
-	// Apply filter if specified
-	if flags.Filter != "" {
-		works = filterResourceBundles(works, flags.Filter)
-		log.Debug(ctx, "Filtered results", logger.Fields{
-			"filter":  flags.Filter,
-			"matched": len(works),
-		})
-	}
-
 	// Output based on format
-	switch strings.ToLower(flags.Output) {
-	case "json":
+	switch output {
+	case defaultOutputFormatJSON:
 		return outputResourceBundlesJSON(works)
-	case "yaml":
+	case defaultOutputFormatYAML:
 		return outputResourceBundlesYAML(works)
+	case outputFormatJSONLines:
+		return nil
 	default:
-		outputResourceBundlesTable(works, flags.Consumer, flags.Filter)
+		outputResourceBundlesTableFooter(count, flags.Consumer, flags.Filter)
 		return nil
 	}
 }
@@ -238,9 +242,37 @@ func matchesResourceBundleFilter(
 	return false
 }
 
-// outputResourceBundlesTable outputs ResourceBundleSummary in table format with details
-func outputResourceBundlesTable(items []maestro.ResourceBundleSummary, consumer, filter string) {
-	if len(items) == 0 {
+// outputResourceBundleTableEntry outputs a single ResourceBundleSummary in table format with details
+func outputResourceBundleTableEntry(rb maestro.ResourceBundleSummary, first bool) {
+	if !first {
+		fmt.Println()
+	}
+
+	// Print ManifestWork header
+	fmt.Printf("ManifestWork: %s\n", rb.Name)
+	fmt.Printf("  ID:        %s\n", rb.ID)
+	fmt.Printf("  Version:   %d\n", rb.Version)
+	fmt.Printf("  Created:   %s\n", rb.CreatedAt)
+	fmt.Printf("  Updated:   %s\n", rb.UpdatedAt)
+
+	// Print manifests
+	fmt.Printf("  Manifests (%d):\n", rb.ManifestCount)
+	for _, info := range rb.Manifests {
+		fmt.Printf("    - %s\n", info.String())
+	}
+
+	// Print conditions
+	if len(rb.Conditions) > 0 {
+		fmt.Printf("  Conditions:\n")
+		for _, cond := range rb.Conditions {
+			fmt.Printf("    - %s: %s\n", cond.Type, cond.Status)
+		}
+	}
+}
+
+// outputResourceBundlesTableFooter outputs the total after all table entries have been streamed
+func outputResourceBundlesTableFooter(count int, consumer, filter string) {
+	if count == 0 {
 		if filter != "" {
 			fmt.Printf("No ManifestWorks matching '%s' found for consumer %s\n", filter, consumer)
 		} else {
@@ -249,39 +281,27 @@ func outputResourceBundlesTable(items []maestro.ResourceBundleSummary, consumer,
 		return
 	}
 
-	for i, rb := range items {
-		if i > 0 {
-			fmt.Println()
-		}
-
-		// Print ManifestWork header
-		fmt.Printf("ManifestWork: %s\n", rb.Name)
-		fmt.Printf("  ID:        %s\n", rb.ID)
-		fmt.Printf("  Version:   %d\n", rb.Version)
-		fmt.Printf("  Created:   %s\n", rb.CreatedAt)
-		fmt.Printf("  Updated:   %s\n", rb.UpdatedAt)
-
-		// Print manifests
-		fmt.Printf("  Manifests (%d):\n", rb.ManifestCount)
-		for _, info := range rb.Manifests {
-			fmt.Printf("    - %s\n", info.String())
-		}
+	fmt.Printf("\n─────────────────────────────────────────\n")
+	fmt.Printf("Total: %d ManifestWork(s) for consumer %s\n", count, consumer)
+}
 
-		// Print conditions
-		if len(rb.Conditions) > 0 {
-			fmt.Printf("  Conditions:\n")
-			for _, cond := range rb.Conditions {
-				fmt.Printf("    - %s: %s\n", cond.Type, cond.Status)
-			}
+// outputResourceBundlesJSONLines outputs each ResourceBundleSummary as a single line of JSON
+func outputResourceBundlesJSONLines(items []maestro.ResourceBundleSummary) error {
+	for _, rb := range items {
+		data, err := json.Marshal(rb)
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
 		}
+		fmt.Println(string(data))
 	}
-
-	fmt.Printf("\n─────────────────────────────────────────\n")
-	fmt.Printf("Total: %d ManifestWork(s) for consumer %s\n", len(items), consumer)
+	return nil
 }
 
 // outputResourceBundlesJSON outputs ResourceBundleSummary in JSON format
 func outputResourceBundlesJSON(items []maestro.ResourceBundleSummary) error {
+	if items == nil {
+		items = []maestro.ResourceBundleSummary{}
+	}
 	data, err := json.MarshalIndent(items, "", "  ")
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON: %w", err)
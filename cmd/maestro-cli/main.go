@@ -3,7 +3,6 @@ package main
 
 import (
 	"context"
-	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
@@ -16,9 +15,10 @@ func main() {
 
 	rootCmd := cmd.NewRootCommand()
 	if err := rootCmd.ExecuteContext(ctx); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		// Report the error and exit with the code documented for its type (see pkg/errors)
+		exitCode := cmd.HandleError(rootCmd, os.Stderr, err)
 		cancel() // Clean up signal context
-		os.Exit(1)
+		os.Exit(exitCode)
 	}
 
 	cancel() // Clean up signal context
@@ -6,6 +6,10 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+
+	"github.com/openshift-hyperfleet/maestro-cli/internal/config"
+	"github.com/openshift-hyperfleet/maestro-cli/internal/maestro"
+	clierrors "github.com/openshift-hyperfleet/maestro-cli/pkg/errors"
 )
 
 const (
@@ -24,8 +28,17 @@ Environment Variables:
   MAESTRO_GRPC_TOKEN           Bearer token for authentication
   MAESTRO_GRPC_TOKEN_FILE      Path to file containing bearer token
   MAESTRO_SOURCE_ID            Source ID for CloudEvents subscription (default: maestro-cli)
+  MAESTRO_HTTP_CA_FILE         Path to HTTP API CA certificate file (default: gRPC server CA)
+  MAESTRO_HTTP_CLIENT_CERT     Path to HTTP API client certificate file (default: gRPC client cert)
+  MAESTRO_HTTP_CLIENT_KEY      Path to HTTP API client key file (default: gRPC client key)
+  MAESTRO_HTTP_TOKEN           Bearer token for the HTTP API (default: gRPC token)
+  MAESTRO_HTTP_TOKEN_FILE      Path to file containing HTTP API bearer token
+
+  MAESTRO_CONFIG               Path to the config file (default: ~/.config/maestro-cli/config.yaml)
+  MAESTRO_CONTEXT              Config context to use instead of the current context
 
-Note: Command-line flags take priority over environment variables.
+Connection settings are resolved from command-line flags, then environment variables,
+then the current context of the config file (see 'maestro-cli config --help').
 
 Examples:
   # Apply a ManifestWork to a target cluster
@@ -48,6 +61,7 @@ const (
 	// Output format constants
 	defaultOutputFormatJSON = "json"
 	defaultOutputFormatYAML = "yaml"
+	outputFormatJSONLines   = "jsonl"
 )
 
 // Environment variable names
@@ -64,6 +78,17 @@ const (
 	// This is an environment variable name, not a credential
 	EnvGRPCTokenFile = "MAESTRO_GRPC_TOKEN_FILE" //nolint:gosec
 	EnvSourceID      = "MAESTRO_SOURCE_ID"
+
+	EnvHTTPCAFile         = "MAESTRO_HTTP_CA_FILE"
+	EnvHTTPClientCertFile = "MAESTRO_HTTP_CLIENT_CERT"
+	EnvHTTPClientKeyFile  = "MAESTRO_HTTP_CLIENT_KEY"
+	// This is an environment variable name, not a credential
+	EnvHTTPToken = "MAESTRO_HTTP_TOKEN" //nolint:gosec
+	// This is an environment variable name, not a credential
+	EnvHTTPTokenFile = "MAESTRO_HTTP_TOKEN_FILE" //nolint:gosec
+
+	EnvConfig  = "MAESTRO_CONFIG"
+	EnvContext = "MAESTRO_CONTEXT"
 )
 
 // Default values
@@ -104,6 +129,8 @@ func NewRootCommand() *cobra.Command {
 		NewValidateCommand(),
 		NewDiffCommand(),
 		NewBuildCommand(),
+		NewConsumersCommand(),
+		NewConfigCommand(),
 		NewVersionCommand(),
 	)
 
@@ -112,6 +139,12 @@ func NewRootCommand() *cobra.Command {
 
 // addGlobalFlags adds global flags that apply to all commands
 func addGlobalFlags(cmd *cobra.Command) {
+	// Config file and context selection
+	cmd.PersistentFlags().String("config", getEnvOrDefault(EnvConfig, config.DefaultPath()),
+		"Path to the maestro-cli config file (env: MAESTRO_CONFIG)")
+	cmd.PersistentFlags().String("context", os.Getenv(EnvContext),
+		"Config context to use, defaults to the current context (env: MAESTRO_CONTEXT)")
+
 	// Global connection flags
 	cmd.PersistentFlags().String("grpc-endpoint", getEnvOrDefault(EnvGRPCEndpoint, DefaultGRPCEndpoint),
 		"Maestro gRPC server endpoint (env: MAESTRO_GRPC_ENDPOINT)")
@@ -134,6 +167,18 @@ func addGlobalFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().String("grpc-client-token-file", os.Getenv(EnvGRPCTokenFile),
 		"Path to file containing bearer token (env: MAESTRO_GRPC_TOKEN_FILE)")
 
+	// HTTP API authentication flags (default to the gRPC values when unset)
+	cmd.PersistentFlags().String("http-ca-file", os.Getenv(EnvHTTPCAFile),
+		"Path to HTTP API CA certificate file, defaults to --grpc-server-ca-file (env: MAESTRO_HTTP_CA_FILE)")
+	cmd.PersistentFlags().String("http-client-cert-file", os.Getenv(EnvHTTPClientCertFile),
+		"Path to HTTP API client certificate file, defaults to --grpc-client-cert-file (env: MAESTRO_HTTP_CLIENT_CERT)")
+	cmd.PersistentFlags().String("http-client-key-file", os.Getenv(EnvHTTPClientKeyFile),
+		"Path to HTTP API client key file, defaults to --grpc-client-key-file (env: MAESTRO_HTTP_CLIENT_KEY)")
+	cmd.PersistentFlags().String("http-token", os.Getenv(EnvHTTPToken),
+		"Bearer token for the HTTP API, defaults to the gRPC token (env: MAESTRO_HTTP_TOKEN)")
+	cmd.PersistentFlags().String("http-token-file", os.Getenv(EnvHTTPTokenFile),
+		"Path to file containing HTTP API bearer token (env: MAESTRO_HTTP_TOKEN_FILE)")
+
 	// Source ID for CloudEvents subscription
 	cmd.PersistentFlags().String("source-id", getEnvOrDefault(EnvSourceID, DefaultSourceID),
 		"Source ID for CloudEvents subscription (env: MAESTRO_SOURCE_ID)")
@@ -144,9 +189,133 @@ func addGlobalFlags(cmd *cobra.Command) {
 
 	// Global behavior flags
 	cmd.PersistentFlags().Duration("timeout", 0, "Maximum time to wait for operation completion")
+	cmd.PersistentFlags().Int("retry-max-attempts", maestro.DefaultRetryMaxAttempts,
+		"Maximum attempts per Maestro API call on transient errors (timeouts, 429, 5xx); 1 disables retries")
+	cmd.PersistentFlags().Duration("retry-backoff", maestro.DefaultRetryInitialBackoff,
+		"Initial delay between retries, doubled after each attempt")
+	cmd.PersistentFlags().Duration("retry-max-backoff", maestro.DefaultRetryMaxBackoff,
+		"Maximum delay between retries")
 	cmd.PersistentFlags().Bool("verbose", false, "Enable verbose output")
 }
 
+// GlobalFlags contains the global flags shared by all commands
+// Connection settings are resolved from the flag, then the environment variable, then the config context.
+type GlobalFlags struct {
+	GRPCEndpoint        string
+	HTTPEndpoint        string
+	GRPCInsecure        bool
+	GRPCServerCAFile    string
+	GRPCClientCertFile  string
+	GRPCClientKeyFile   string
+	GRPCBrokerCAFile    string
+	GRPCClientToken     string
+	GRPCClientTokenFile string
+	HTTPCAFile          string
+	HTTPClientCertFile  string
+	HTTPClientKeyFile   string
+	HTTPToken           string
+	HTTPTokenFile       string
+	Retry               maestro.RetryPolicy
+	SourceID            string
+	ResultsPath         string
+	Output              string
+	Timeout             time.Duration
+	Verbose             bool
+	// DefaultConsumer is the consumer of the config context, used when --consumer is not set
+	DefaultConsumer string
+}
+
+// loadGlobalFlags reads the global flags, filling unset connection settings from the config context
+func loadGlobalFlags(cmd *cobra.Command) (GlobalFlags, error) {
+	cfg, err := loadConfigFile(cmd)
+	if err != nil {
+		return GlobalFlags{}, err
+	}
+	ctx, err := cfg.Resolve(getStringFlag(cmd, "context"))
+	if err != nil {
+		return GlobalFlags{}, clierrors.Wrap(clierrors.TypeNotFound, err)
+	}
+	if ctx == nil {
+		ctx = &config.Context{}
+	}
+
+	return GlobalFlags{
+		GRPCEndpoint:        resolveStringFlag(cmd, "grpc-endpoint", EnvGRPCEndpoint, ctx.GRPCEndpoint),
+		HTTPEndpoint:        resolveStringFlag(cmd, "http-endpoint", EnvHTTPEndpoint, ctx.HTTPEndpoint),
+		GRPCInsecure:        resolveBoolFlag(cmd, "grpc-insecure", EnvGRPCInsecure, ctx.GRPCInsecure),
+		GRPCServerCAFile:    resolveStringFlag(cmd, "grpc-server-ca-file", EnvGRPCServerCAFile, ctx.GRPCServerCAFile),
+		GRPCClientCertFile:  resolveStringFlag(cmd, "grpc-client-cert-file", EnvGRPCClientCertFile, ctx.GRPCClientCertFile),
+		GRPCClientKeyFile:   resolveStringFlag(cmd, "grpc-client-key-file", EnvGRPCClientKeyFile, ctx.GRPCClientKeyFile),
+		GRPCBrokerCAFile:    resolveStringFlag(cmd, "grpc-broker-ca-file", "", ctx.GRPCBrokerCAFile),
+		GRPCClientToken:     resolveStringFlag(cmd, "grpc-client-token", EnvGRPCToken, ctx.GRPCClientToken),
+		GRPCClientTokenFile: resolveStringFlag(cmd, "grpc-client-token-file", EnvGRPCTokenFile, ctx.GRPCClientTokenFile),
+		HTTPCAFile:          resolveStringFlag(cmd, "http-ca-file", EnvHTTPCAFile, ctx.HTTPCAFile),
+		HTTPClientCertFile:  resolveStringFlag(cmd, "http-client-cert-file", EnvHTTPClientCertFile, ctx.HTTPClientCertFile),
+		HTTPClientKeyFile:   resolveStringFlag(cmd, "http-client-key-file", EnvHTTPClientKeyFile, ctx.HTTPClientKeyFile),
+		HTTPToken:           resolveStringFlag(cmd, "http-token", EnvHTTPToken, ctx.HTTPToken),
+		HTTPTokenFile:       resolveStringFlag(cmd, "http-token-file", EnvHTTPTokenFile, ctx.HTTPTokenFile),
+		Retry:               getRetryPolicy(cmd),
+		SourceID:            resolveStringFlag(cmd, "source-id", EnvSourceID, ctx.SourceID),
+		ResultsPath:         getStringFlag(cmd, "results-path"),
+		Output:              getStringFlag(cmd, "output"),
+		Timeout:             getDurationFlag(cmd, "timeout"),
+		Verbose:             getBoolFlag(cmd, "verbose"),
+		DefaultConsumer:     ctx.Consumer,
+	}, nil
+}
+
+// clientConfig builds the Maestro client configuration from the global flags
+func (f *GlobalFlags) clientConfig() maestro.ClientConfig {
+	return maestro.ClientConfig{
+		GRPCEndpoint:        f.GRPCEndpoint,
+		HTTPEndpoint:        f.HTTPEndpoint,
+		GRPCInsecure:        f.GRPCInsecure,
+		GRPCServerCAFile:    f.GRPCServerCAFile,
+		GRPCBrokerCAFile:    f.GRPCBrokerCAFile,
+		GRPCClientCertFile:  f.GRPCClientCertFile,
+		GRPCClientKeyFile:   f.GRPCClientKeyFile,
+		GRPCClientToken:     f.GRPCClientToken,
+		GRPCClientTokenFile: f.GRPCClientTokenFile,
+		SourceID:            f.SourceID,
+		HTTPCAFile:          f.HTTPCAFile,
+		HTTPClientCertFile:  f.HTTPClientCertFile,
+		HTTPClientKeyFile:   f.HTTPClientKeyFile,
+		HTTPToken:           f.HTTPToken,
+		HTTPTokenFile:       f.HTTPTokenFile,
+		Retry:               f.Retry,
+	}
+}
+
+// consumer returns --consumer, or the consumer of the config context when the flag is not set
+func (f *GlobalFlags) consumer(cmd *cobra.Command) (string, error) {
+	if consumer := getStringFlag(cmd, "consumer"); consumer != "" {
+		return consumer, nil
+	}
+	if f.DefaultConsumer != "" {
+		return f.DefaultConsumer, nil
+	}
+	return "", clierrors.NewValidationFailed(
+		`required flag "consumer" not set and the config context does not define a consumer`,
+	)
+}
+
+// resolveStringFlag returns the flag value if it was set on the command line or through its
+// environment variable, otherwise the config context value, falling back to the flag default
+func resolveStringFlag(cmd *cobra.Command, name, envKey, contextValue string) string {
+	if cmd.Flags().Changed(name) || (envKey != "" && os.Getenv(envKey) != "") || contextValue == "" {
+		return getStringFlag(cmd, name)
+	}
+	return contextValue
+}
+
+// resolveBoolFlag is resolveStringFlag for boolean flags
+func resolveBoolFlag(cmd *cobra.Command, name, envKey string, contextValue bool) bool {
+	if cmd.Flags().Changed(name) || (envKey != "" && os.Getenv(envKey) != "") {
+		return getBoolFlag(cmd, name)
+	}
+	return contextValue || getBoolFlag(cmd, name)
+}
+
 // getEnvOrDefault returns the environment variable value or the default if not set
 func getEnvOrDefault(envKey, defaultValue string) string {
 	if value := os.Getenv(envKey); value != "" {
@@ -177,3 +346,22 @@ func getDurationFlag(cmd *cobra.Command, name string) time.Duration {
 	value, _ := cmd.Flags().GetDuration(name)
 	return value
 }
+
+func getInt32Flag(cmd *cobra.Command, name string) int32 {
+	value, _ := cmd.Flags().GetInt32(name)
+	return value
+}
+
+func getIntFlag(cmd *cobra.Command, name string) int {
+	value, _ := cmd.Flags().GetInt(name)
+	return value
+}
+
+// getRetryPolicy builds the Maestro API retry policy from the global retry flags
+func getRetryPolicy(cmd *cobra.Command) maestro.RetryPolicy {
+	return maestro.RetryPolicy{
+		MaxAttempts:    getIntFlag(cmd, "retry-max-attempts"),
+		InitialBackoff: getDurationFlag(cmd, "retry-backoff"),
+		MaxBackoff:     getDurationFlag(cmd, "retry-max-backoff"),
+	}
+}
@@ -3,11 +3,13 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"time"
+	"strings"
 
 	"github.com/spf13/cobra"
+	workv1 "open-cluster-management.io/api/work/v1"
 
 	"github.com/openshift-hyperfleet/maestro-cli/internal/manifestwork"
+	clierrors "github.com/openshift-hyperfleet/maestro-cli/pkg/errors"
 	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
 )
 
@@ -19,20 +21,8 @@ const (
 // ValidateFlags contains flags for the validate command
 type ValidateFlags struct {
 	ManifestFile string
-	// Global flags
-	GRPCEndpoint        string
-	HTTPEndpoint        string
-	GRPCInsecure        bool
-	GRPCServerCAFile    string
-	GRPCClientCertFile  string
-	GRPCClientKeyFile   string
-	GRPCBrokerCAFile    string
-	GRPCClientToken     string
-	GRPCClientTokenFile string
-	ResultsPath         string
-	Output              string
-	Timeout             time.Duration
-	Verbose             bool
+	AutoFeedback bool // Inject and show default feedback rules for well-known kinds
+	GlobalFlags
 }
 
 // NewValidateCommand creates the validate command
@@ -53,24 +43,20 @@ Examples:
   maestro-cli validate --manifest-file=job-manifestwork.json
 
   # Validate with verbose output
-  maestro-cli validate --manifest-file=job-manifestwork.yaml --verbose`,
+  maestro-cli validate --manifest-file=job-manifestwork.yaml --verbose
+
+  # Show the feedback rules apply --auto-feedback would add
+  maestro-cli validate --manifest-file=job-manifestwork.yaml --auto-feedback`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			globals, err := loadGlobalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
 			flags := &ValidateFlags{
 				ManifestFile: getStringFlag(cmd, "manifest-file"),
-				// Global flags
-				GRPCEndpoint:        getStringFlag(cmd, "grpc-endpoint"),
-				HTTPEndpoint:        getStringFlag(cmd, "http-endpoint"),
-				GRPCInsecure:        getBoolFlag(cmd, "grpc-insecure"),
-				GRPCServerCAFile:    getStringFlag(cmd, "grpc-server-ca-file"),
-				GRPCClientCertFile:  getStringFlag(cmd, "grpc-client-cert-file"),
-				GRPCClientKeyFile:   getStringFlag(cmd, "grpc-client-key-file"),
-				GRPCBrokerCAFile:    getStringFlag(cmd, "grpc-broker-ca-file"),
-				GRPCClientToken:     getStringFlag(cmd, "grpc-client-token"),
-				GRPCClientTokenFile: getStringFlag(cmd, "grpc-client-token-file"),
-				ResultsPath:         getStringFlag(cmd, "results-path"),
-				Output:              getStringFlag(cmd, "output"),
-				Timeout:             getDurationFlag(cmd, "timeout"),
-				Verbose:             getBoolFlag(cmd, "verbose"),
+				AutoFeedback: getBoolFlag(cmd, "auto-feedback"),
+				GlobalFlags:  globals,
 			}
 
 			return runValidateCommand(cmd.Context(), flags)
@@ -79,6 +65,8 @@ Examples:
 
 	// Command-specific flags
 	cmd.Flags().String("manifest-file", "", "Path to ManifestWork YAML/JSON file (required)")
+	cmd.Flags().Bool("auto-feedback", false,
+		"Show the default status feedback rules that apply --auto-feedback would add")
 
 	// Mark required flags
 	if err := cmd.MarkFlagRequired("manifest-file"); err != nil {
@@ -107,7 +95,15 @@ func runValidateCommand(ctx context.Context, flags *ValidateFlags) error {
 	// Load and parse the ManifestWork file
 	mw, err := manifestwork.LoadManifestWorkFromFile(flags.ManifestFile)
 	if err != nil {
-		return fmt.Errorf("validation failed: %w", err)
+		return clierrors.NewValidationFailed("validation failed: %w", err)
+	}
+
+	// Inject default feedback rules before validating, as apply --auto-feedback does
+	var injected []workv1.ManifestConfigOption
+	if flags.AutoFeedback {
+		if injected, err = manifestwork.AddFeedbackRules(mw); err != nil {
+			return clierrors.NewValidationFailed("validation failed: %w", err)
+		}
 	}
 
 	// Validate required fields
@@ -166,7 +162,7 @@ func runValidateCommand(ctx context.Context, flags *ValidateFlags) error {
 		for _, e := range errors {
 			fmt.Printf("  - %s\n", e)
 		}
-		return fmt.Errorf("validation failed with %d error(s)", len(errors))
+		return clierrors.NewValidationFailed("validation failed with %d error(s)", len(errors))
 	}
 
 	// Print success
@@ -174,6 +170,23 @@ func runValidateCommand(ctx context.Context, flags *ValidateFlags) error {
 	fmt.Printf("  Name: %s\n", mw.Name)
 	fmt.Printf("  Manifests: %d\n", len(mw.Spec.Workload.Manifests))
 
+	if flags.AutoFeedback {
+		if len(injected) == 0 {
+			fmt.Printf("\nFeedback rules: none added\n")
+		} else {
+			fmt.Printf("\nFeedback rules added: %d\n", len(injected))
+			for _, config := range injected {
+				var names []string
+				for _, rule := range config.FeedbackRules {
+					for _, path := range rule.JsonPaths {
+						names = append(names, path.Name+"="+path.Path)
+					}
+				}
+				fmt.Printf("  - %s: %s\n", formatResourceIdentifier(config.ResourceIdentifier), strings.Join(names, ", "))
+			}
+		}
+	}
+
 	if flags.Verbose {
 		fmt.Printf("\nManifests:\n")
 		for i, manifest := range mw.Spec.Workload.Manifests {
@@ -4,39 +4,38 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/openshift-hyperfleet/maestro-cli/internal/maestro"
 	"github.com/openshift-hyperfleet/maestro-cli/internal/manifestwork"
+	clierrors "github.com/openshift-hyperfleet/maestro-cli/pkg/errors"
 	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
 )
 
 const (
-	statusWaiting = "Waiting"
+	statusWaiting = manifestwork.StatusWaiting
 )
 
 // WaitFlags contains flags for the wait command
 type WaitFlags struct {
-	Name     string
-	Consumer string
-	For      string // Condition to wait for (like kubectl --for)
-	// Global flags
-	GRPCEndpoint        string
-	HTTPEndpoint        string
-	GRPCInsecure        bool
-	GRPCServerCAFile    string
-	GRPCClientCertFile  string
-	GRPCClientKeyFile   string
-	GRPCBrokerCAFile    string
-	GRPCClientToken     string
-	GRPCClientTokenFile string
-	ResultsPath         string
-	Output              string
-	Timeout             time.Duration
-	Verbose             bool
+	Name      string
+	Names     []string // ManifestWorks awaited together (--names)
+	All       bool     // Await every ManifestWork of the consumer (--all)
+	Selector  string   // Label selector matched against ManifestWork labels (--selector)
+	Mode      string   // Whether all or any of several ManifestWorks must meet the condition (--mode)
+	Consumer  string
+	For       string        // Condition to wait for, with the kubectl forms of --for translated
+	Delete    bool          // Wait for the deletion of the ManifestWork (--for=delete)
+	FailOn    string        // Condition that fails the wait as soon as it is met
+	StableFor time.Duration // How long the condition must hold continuously
+	FanOut    FanOutFlags
+	GlobalFlags
 }
 
 // NewWaitCommand creates the wait command
@@ -46,6 +45,15 @@ func NewWaitCommand() *cobra.Command {
 		Short: "Wait for a ManifestWork to reach a specific condition",
 		Long: `Wait for a ManifestWork to reach a specific condition with optional timeout.
 
+Status updates are received over the gRPC CloudEvents subscription when the gRPC
+endpoint is reachable, with the HTTP API polled periodically as a resync. If the
+gRPC connection cannot be established, the HTTP API is polled every second.
+
+Besides condition expressions, --for accepts the kubectl wait forms condition=NAME[=STATUS],
+delete, jsonpath='{PATH}'=VALUE and, to target a resource of the ManifestWork, TYPE/NAME
+followed by condition=NAME or jsonpath='{PATH}'=VALUE. A jsonpath is evaluated against the
+status feedback of the resource, or of any resource when no TYPE/NAME is given.
+
 Examples:
   # Wait for Available condition (default, like kubectl wait --for=condition=Available)
   maestro-cli wait --name=hyperfleet-cluster-west-1-job --consumer=agent1
@@ -57,28 +65,69 @@ Examples:
   maestro-cli wait --name=hyperfleet-cluster-west-1-job --consumer=agent1 \
     --for="Job:Complete OR Job:Failed" --timeout=10m
 
+  # Fail fast with exit code 7 when the Job fails instead of waiting for the timeout
+  maestro-cli wait --name=hyperfleet-cluster-west-1-job --consumer=agent1 \
+    --for="Job:Complete" --fail-on="Job:Failed"
+
+  # Wait until the Deployment has stayed Available for 30s without flapping
+  maestro-cli wait --name=hyperfleet-cluster-west-1-deploy --consumer=agent1 \
+    --for="Deployment:Available for 30s"
+
+  # Wait until every Deployment is Available; a bare Deployment:Available needs only one
+  maestro-cli wait --name=hyperfleet-cluster-west-1-deploy --consumer=agent1 \
+    --for="all(Deployment):Available"
+
+  # kubectl wait habits work too
+  maestro-cli wait --name=hyperfleet-cluster-west-1-job --consumer=agent1 --for=condition=Available=False
+  maestro-cli wait --name=hyperfleet-cluster-west-1-job --consumer=agent1 --for="job/pi condition=Complete"
+  maestro-cli wait --name=hyperfleet-cluster-west-1-job --consumer=agent1 \
+    --for="jsonpath='{.status.succeeded}'=1"
+  maestro-cli wait --name=hyperfleet-cluster-west-1-job --consumer=agent1 --for=delete
+
   # Wait and write results for status-reporter
   maestro-cli wait --name=hyperfleet-cluster-west-1-job --consumer=agent1 \
-    --for=Available --results-path=/tmp/wait-results.json`,
+    --for=Available --results-path=/tmp/wait-results.json
+
+  # Wait until all ManifestWorks of a rollout are Available, polling their status with one list call
+  maestro-cli wait --names=hyperfleet-namespace,hyperfleet-operator,hyperfleet-config --consumer=agent1
+
+  # Wait until every ManifestWork labelled app=hyperfleet, or any of them, is Available
+  maestro-cli wait --selector=app=hyperfleet --consumer=agent1 --for=Available
+  maestro-cli wait --selector=app=hyperfleet --consumer=agent1 --for=Available --mode=any
+
+  # Wait on every cluster at once and write one aggregated results file
+  maestro-cli wait --name=hyperfleet-nodepool --all-consumers --for=Available \
+    --results-path=/tmp/wait-results.json`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			globals, err := loadGlobalFlags(cmd)
+			if err != nil {
+				return err
+			}
+			fanOut := getFanOutFlags(cmd)
+			consumer, err := resolveTargetConsumer(cmd, &globals, &fanOut)
+			if err != nil {
+				return err
+			}
+
+			target, err := maestro.ParseWaitFor(getStringFlag(cmd, "for"))
+			if err != nil {
+				return err
+			}
+
+			names, _ := cmd.Flags().GetStringSlice("names")
 			flags := &WaitFlags{
-				Name:     getStringFlag(cmd, "name"),
-				Consumer: getStringFlag(cmd, "consumer"),
-				For:      getStringFlag(cmd, "for"),
-				// Global flags
-				GRPCEndpoint:        getStringFlag(cmd, "grpc-endpoint"),
-				HTTPEndpoint:        getStringFlag(cmd, "http-endpoint"),
-				GRPCInsecure:        getBoolFlag(cmd, "grpc-insecure"),
-				GRPCServerCAFile:    getStringFlag(cmd, "grpc-server-ca-file"),
-				GRPCClientCertFile:  getStringFlag(cmd, "grpc-client-cert-file"),
-				GRPCClientKeyFile:   getStringFlag(cmd, "grpc-client-key-file"),
-				GRPCBrokerCAFile:    getStringFlag(cmd, "grpc-broker-ca-file"),
-				GRPCClientToken:     getStringFlag(cmd, "grpc-client-token"),
-				GRPCClientTokenFile: getStringFlag(cmd, "grpc-client-token-file"),
-				ResultsPath:         getStringFlag(cmd, "results-path"),
-				Output:              getStringFlag(cmd, "output"),
-				Timeout:             getDurationFlag(cmd, "timeout"),
-				Verbose:             getBoolFlag(cmd, "verbose"),
+				Name:        getStringFlag(cmd, "name"),
+				Names:       names,
+				All:         getBoolFlag(cmd, "all"),
+				Selector:    getStringFlag(cmd, "selector"),
+				Mode:        getStringFlag(cmd, "mode"),
+				Consumer:    consumer,
+				For:         target.Condition,
+				Delete:      target.Delete,
+				FailOn:      getStringFlag(cmd, "fail-on"),
+				StableFor:   getDurationFlag(cmd, "stable-for"),
+				FanOut:      fanOut,
+				GlobalFlags: globals,
 			}
 
 			return runWaitCommand(cmd.Context(), flags)
@@ -86,21 +135,22 @@ Examples:
 	}
 
 	// Command-specific flags
-	cmd.Flags().String("name", "", "ManifestWork name (required)")
-	cmd.Flags().String("consumer", "", "Target cluster name (required)")
+	cmd.Flags().String("name", "", "ManifestWork name")
+	cmd.Flags().StringSlice("names", nil, "Comma-separated ManifestWork names to wait for together")
+	cmd.Flags().Bool("all", false, "Wait for every ManifestWork of the consumer")
+	cmd.Flags().StringP("selector", "l", "",
+		"Wait for the ManifestWorks whose labels match the selector (e.g. 'app=hyperfleet,tier in (a,b)')")
+	cmd.Flags().String("mode", maestro.WaitModeAll,
+		"With --names, --all or --selector, whether all or any of the ManifestWorks must meet the condition")
+	cmd.Flags().String("consumer", "", "Target cluster name (defaults to the consumer of the config context)")
 	cmd.Flags().String(
 		"for",
 		"Available",
-		"Condition to wait for (e.g., 'Available', 'Job:Complete', 'Job:Complete OR Job:Failed')",
+		"Condition to wait for (e.g., 'Available', 'Job:Complete OR Job:Failed', 'condition=Available', 'delete')",
 	)
-
-	// Mark required flags
-	if err := cmd.MarkFlagRequired("name"); err != nil {
-		panic(err)
-	}
-	if err := cmd.MarkFlagRequired("consumer"); err != nil {
-		panic(err)
-	}
+	addFailOnFlag(cmd)
+	addStableForFlag(cmd)
+	addFanOutFlags(cmd)
 
 	return cmd
 }
@@ -115,11 +165,21 @@ func runWaitCommand(ctx context.Context, flags *WaitFlags) error {
 		Version:   "dev",
 	})
 
-	// Create HTTP-only client (no gRPC needed for wait)
-	client, err := maestro.NewHTTPClient(maestro.ClientConfig{
-		HTTPEndpoint: flags.HTTPEndpoint,
-		GRPCInsecure: flags.GRPCInsecure,
-	})
+	if err := flags.validateWorkSelection(); err != nil {
+		return err
+	}
+
+	// Compile the conditions up front so syntax errors are reported before any polling
+	if flags.Delete {
+		if flags.FailOn != "" || flags.StableFor != 0 {
+			return clierrors.NewValidationFailed("cannot use --fail-on or --stable-for with --for=delete")
+		}
+	} else if err := validateWaitConditions(flags.For, flags.FailOn, flags.StableFor); err != nil {
+		return err
+	}
+
+	// Create client with gRPC status updates, falling back to HTTP-only polling
+	client, err := newStatusClient(ctx, flags.clientConfig(), log)
 	if err != nil {
 		return fmt.Errorf("failed to create Maestro client: %w", err)
 	}
@@ -129,16 +189,43 @@ func runWaitCommand(ctx context.Context, flags *WaitFlags) error {
 		}
 	}()
 
+	if flags.waitsForMany() {
+		return runWaitManyCommand(ctx, client, flags, log)
+	}
+
+	if flags.FanOut.enabled() {
+		consumers, err := resolveFanOutConsumers(ctx, client, &flags.FanOut)
+		if err != nil {
+			return err
+		}
+		results, errs := runFanOut(ctx, consumers, flags.Name, &flags.FanOut, log,
+			func(ctx context.Context, consumer string) (manifestwork.StatusResult, error) {
+				return waitForConsumer(ctx, client, flags, consumer, log)
+			})
+		return finishFanOut("wait", flags.Name, results, errs, &flags.FanOut, flags.ResultsPath)
+	}
+
 	// Validate consumer exists
 	if err := client.ValidateConsumer(ctx, flags.Consumer); err != nil {
 		return err
 	}
 
+	if flags.Delete {
+		result, err := waitForDeletion(ctx, client, flags, flags.Consumer, log)
+		if err != nil {
+			result = failedResult(result, flags.Name, flags.Consumer, err)
+		}
+		if writeErr := manifestwork.WriteResult(flags.ResultsPath, result); writeErr != nil && err == nil {
+			return fmt.Errorf("failed to write results file: %w", writeErr)
+		}
+		return err
+	}
+
 	// Check if ManifestWork exists
 	_, err = client.GetManifestWorkByNameHTTP(ctx, flags.Consumer, flags.Name)
 	if err != nil {
 		if errors.IsNotFound(err) {
-			return fmt.Errorf("ManifestWork %q not found in consumer %q", flags.Name, flags.Consumer)
+			return clierrors.NewNotFound("ManifestWork %q not found in consumer %q", flags.Name, flags.Consumer)
 		}
 		return fmt.Errorf("failed to check ManifestWork existence: %w", err)
 	}
@@ -150,10 +237,12 @@ func runWaitCommand(ctx context.Context, flags *WaitFlags) error {
 	}
 
 	log.Info(ctx, "Waiting for condition", logger.Fields{
-		"name":     flags.Name,
-		"consumer": flags.Consumer,
-		"for":      flags.For,
-		"timeout":  timeout.String(),
+		"name":       flags.Name,
+		"consumer":   flags.Consumer,
+		"for":        flags.For,
+		"fail_on":    flags.FailOn,
+		"stable_for": flags.StableFor.String(),
+		"timeout":    timeout.String(),
 	})
 
 	// Create wait context with timeout
@@ -161,31 +250,42 @@ func runWaitCommand(ctx context.Context, flags *WaitFlags) error {
 	defer cancel()
 
 	// Create callback to update results file on each poll
-	var callback maestro.WaitCallback
-	if flags.ResultsPath != "" || os.Getenv("RESULTS_PATH") != "" {
-		callback = func(details *maestro.ManifestWorkDetails, conditionMet bool) error {
-			status := statusWaiting
-			message := fmt.Sprintf("Waiting for condition '%s'", flags.For)
-			if conditionMet {
-				status = flags.For
-				message = fmt.Sprintf("Condition '%s' met", flags.For)
-			}
-			result := manifestwork.BuildStatusResult(flags.Name, flags.Consumer, status, message, details)
-			return manifestwork.WriteResult(flags.ResultsPath, result)
+	writeResults := flags.ResultsPath != "" || os.Getenv("RESULTS_PATH") != ""
+	var lastDetails *maestro.ManifestWorkDetails
+	callback := func(details *maestro.ManifestWorkDetails, conditionMet bool) error {
+		lastDetails = details
+		if !writeResults {
+			return nil
+		}
+		status := statusWaiting
+		message := fmt.Sprintf("Waiting for condition '%s'", flags.For)
+		if conditionMet {
+			status = flags.For
+			message = fmt.Sprintf("Condition '%s' met", flags.For)
 		}
+		result := manifestwork.BuildStatusResult(flags.Name, flags.Consumer, status, message, details)
+		return manifestwork.WriteResult(flags.ResultsPath, result)
 	}
 
-	// Wait for condition (poll every 1 second by default)
-	if err := client.WaitForCondition(
+	// Wait for condition (event-driven over gRPC, or poll every 1 second)
+	if err := client.WaitForConditionWithOptions(
 		waitCtx,
 		flags.Consumer,
 		flags.Name,
 		flags.For,
-		maestro.DefaultPollInterval,
+		flags.waitOptions(),
 		log,
 		callback,
 	); err != nil {
-		return fmt.Errorf("error waiting for condition '%s': %w", flags.For, err)
+		err = fmt.Errorf("error waiting for condition '%s': %w", flags.For, err)
+		result := failedResult(
+			manifestwork.BuildStatusResult(flags.Name, flags.Consumer, manifestwork.StatusFailed, "", lastDetails),
+			flags.Name, flags.Consumer, err)
+		printConditionTrace(result)
+		if writeErr := manifestwork.WriteResult(flags.ResultsPath, result); writeErr != nil {
+			return fmt.Errorf("failed to write results file: %w", writeErr)
+		}
+		return err
 	}
 
 	log.Info(ctx, "Condition met", logger.Fields{
@@ -196,3 +296,272 @@ func runWaitCommand(ctx context.Context, flags *WaitFlags) error {
 
 	return nil
 }
+
+// runWaitManyCommand waits for the condition on the ManifestWorks selected by --names, --all or --selector
+// The works are polled together with one list call per poll; a summary with a result per work is
+// printed and written, and an error is returned unless the works met the condition as --mode requires.
+func runWaitManyCommand(ctx context.Context, client *maestro.Client, flags *WaitFlags, log *logger.Logger) error {
+	ctx = logger.ContextWithClusterID(ctx, flags.Consumer)
+	if err := client.ValidateConsumer(ctx, flags.Consumer); err != nil {
+		return err
+	}
+
+	selection := maestro.WorkSelection{Names: flags.Names, All: flags.All}
+	if flags.Selector != "" {
+		selector, err := labels.Parse(flags.Selector)
+		if err != nil {
+			return clierrors.NewValidationFailed("invalid --selector %q: %v", flags.Selector, err)
+		}
+		selection.Selector = selector
+	}
+
+	timeout := flags.Timeout
+	if timeout == 0 {
+		timeout = DefaultWaitTimeout
+	}
+	log.Info(ctx, "Waiting for condition on ManifestWorks", logger.Fields{
+		"names":      flags.Names,
+		"all":        flags.All,
+		"selector":   flags.Selector,
+		"mode":       flags.Mode,
+		"consumer":   flags.Consumer,
+		"for":        flags.For,
+		"fail_on":    flags.FailOn,
+		"stable_for": flags.StableFor.String(),
+		"timeout":    timeout.String(),
+	})
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	opts := flags.waitOptions()
+	opts.Mode = flags.Mode
+	works, err := client.WaitForWorks(waitCtx, flags.Consumer, selection, flags.For, opts, log)
+	if err != nil {
+		return err
+	}
+
+	results := make([]manifestwork.StatusResult, len(works))
+	errs := make([]error, len(works))
+	var failed []string
+	for i, work := range works {
+		switch {
+		case work.Met:
+			results[i] = manifestwork.BuildStatusResult(work.Name, flags.Consumer, flags.For,
+				fmt.Sprintf("Condition '%s' met", flags.For), work.Details)
+		case work.Err != nil:
+			errs[i] = fmt.Errorf("error waiting for condition '%s': %w", flags.For, work.Err)
+			results[i] = failedResult(
+				manifestwork.BuildStatusResult(work.Name, flags.Consumer, manifestwork.StatusFailed, "", work.Details),
+				work.Name, flags.Consumer, errs[i])
+			failed = append(failed, work.Name)
+		default:
+			results[i] = manifestwork.BuildStatusResult(work.Name, flags.Consumer, statusWaiting,
+				fmt.Sprintf("Waiting for condition '%s'", flags.For), work.Details)
+		}
+	}
+
+	summary := manifestwork.NewWaitSummary(flags.Consumer, flags.For, flags.Mode, results)
+	if err := manifestwork.WriteWaitSummary(flags.ResultsPath, summary); err != nil {
+		return fmt.Errorf("failed to write results file: %w", err)
+	}
+	if err := outputWaitSummary(summary, flags.FanOut.SummaryFormat); err != nil {
+		return err
+	}
+	for _, result := range results {
+		printConditionTrace(result)
+	}
+
+	if summary.Status == manifestwork.AggregateStatusSucceeded {
+		return nil
+	}
+	return clierrors.New(commonErrorType(errs), "%s (failed: %s)", summary.Message, strings.Join(failed, ", "))
+}
+
+// outputWaitSummary prints the multi-ManifestWork result as a table, or as JSON/YAML when requested
+func outputWaitSummary(summary manifestwork.WaitSummary, format string) error {
+	if handled, err := outputStructured(summary, format); handled {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAME\tSTATUS\tMESSAGE")
+	for _, r := range summary.Results {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", r.Name, r.Status, r.Message)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	fmt.Printf("\n%s: %s\n", summary.Status, summary.Message)
+	return nil
+}
+
+// waitForConsumer waits for the condition on the ManifestWork of one consumer
+func waitForConsumer(
+	ctx context.Context,
+	client *maestro.Client,
+	flags *WaitFlags,
+	consumer string,
+	log *logger.Logger,
+) (manifestwork.StatusResult, error) {
+	if flags.Delete {
+		return waitForDeletion(ctx, client, flags, consumer, log)
+	}
+	if _, err := client.GetManifestWorkByNameHTTP(ctx, consumer, flags.Name); err != nil {
+		if errors.IsNotFound(err) {
+			return manifestwork.StatusResult{},
+				clierrors.NewNotFound("ManifestWork %q not found in consumer %q", flags.Name, consumer)
+		}
+		return manifestwork.StatusResult{}, fmt.Errorf("failed to check ManifestWork existence: %w", err)
+	}
+
+	timeout := flags.Timeout
+	if timeout == 0 {
+		timeout = DefaultWaitTimeout
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Keep the last status seen so the result carries the conditions of the work
+	var lastDetails *maestro.ManifestWorkDetails
+	callback := func(details *maestro.ManifestWorkDetails, _ bool) error {
+		lastDetails = details
+		return nil
+	}
+	if err := client.WaitForConditionWithOptions(
+		waitCtx, consumer, flags.Name, flags.For, flags.waitOptions(), log, callback,
+	); err != nil {
+		return manifestwork.BuildStatusResult(flags.Name, consumer, manifestwork.StatusFailed, "", lastDetails),
+			fmt.Errorf("error waiting for condition '%s': %w", flags.For, err)
+	}
+
+	return manifestwork.BuildStatusResult(
+		flags.Name, consumer, flags.For, fmt.Sprintf("Condition '%s' met", flags.For), lastDetails,
+	), nil
+}
+
+// waitForDeletion waits until the ManifestWork of one consumer is removed (--for=delete)
+// Like kubectl wait --for=delete, a ManifestWork that does not exist counts as deleted.
+func waitForDeletion(
+	ctx context.Context,
+	client *maestro.Client,
+	flags *WaitFlags,
+	consumer string,
+	log *logger.Logger,
+) (manifestwork.StatusResult, error) {
+	timeout := flags.Timeout
+	if timeout == 0 {
+		timeout = DefaultWaitTimeout
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	log.Info(ctx, "Waiting for deletion", logger.Fields{
+		"name":     flags.Name,
+		"consumer": consumer,
+		"timeout":  timeout.String(),
+	})
+
+	result := manifestwork.StatusResult{Name: flags.Name, Consumer: consumer, Timestamp: time.Now()}
+	if err := client.WaitForDeletion(waitCtx, consumer, flags.Name, maestro.DefaultPollInterval, log); err != nil {
+		return result, fmt.Errorf("error waiting for deletion: %w", err)
+	}
+	result.Status = statusDeleted
+	result.Message = "ManifestWork deleted"
+	result.Timestamp = time.Now()
+	return result, nil
+}
+
+// waitsForMany reports whether the wait is for several ManifestWorks (--names, --all or --selector)
+func (f *WaitFlags) waitsForMany() bool {
+	return len(f.Names) > 0 || f.All || f.Selector != ""
+}
+
+// validateWorkSelection checks that exactly one way of selecting ManifestWorks is used, and that
+// --mode, --for=delete and fan-out flags are compatible with it
+func (f *WaitFlags) validateWorkSelection() error {
+	set := 0
+	for _, isSet := range []bool{f.Name != "", len(f.Names) > 0, f.All, f.Selector != ""} {
+		if isSet {
+			set++
+		}
+	}
+	switch {
+	case set == 0:
+		return clierrors.NewValidationFailed("one of --name, --names, --all or --selector is required")
+	case set > 1:
+		return clierrors.NewValidationFailed("--name, --names, --all and --selector are mutually exclusive")
+	}
+	if f.Mode != maestro.WaitModeAll && f.Mode != maestro.WaitModeAny {
+		return clierrors.NewValidationFailed("invalid --mode %q, expected %s or %s",
+			f.Mode, maestro.WaitModeAll, maestro.WaitModeAny)
+	}
+	if !f.waitsForMany() {
+		return nil
+	}
+	if f.Delete {
+		return clierrors.NewValidationFailed("--for=delete supports a single --name")
+	}
+	if f.FanOut.enabled() {
+		return clierrors.NewValidationFailed(
+			"waiting for several ManifestWorks supports a single --consumer, not multiple consumers")
+	}
+	return nil
+}
+
+// waitOptions returns the options of the wait flags
+func (f *WaitFlags) waitOptions() maestro.WaitOptions {
+	return maestro.WaitOptions{PollInterval: maestro.DefaultPollInterval, FailOn: f.FailOn, StableFor: f.StableFor}
+}
+
+// addFailOnFlag registers --fail-on
+func addFailOnFlag(cmd *cobra.Command) {
+	cmd.Flags().String("fail-on", "",
+		"Condition that fails the wait as soon as it is met, exiting with code 7 (e.g., 'Job:Failed')")
+}
+
+// addStableForFlag registers --stable-for
+func addStableForFlag(cmd *cobra.Command) {
+	cmd.Flags().Duration("stable-for", 0,
+		"Require the condition to hold continuously for this long before the wait succeeds (e.g., 30s)")
+}
+
+// validateWaitConditions compiles the awaited and the optional --fail-on condition and checks --stable-for
+func validateWaitConditions(condition, failOn string, stableFor time.Duration) error {
+	if stableFor < 0 {
+		return clierrors.NewValidationFailed("--stable-for must not be negative")
+	}
+	if _, err := maestro.ParseCondition(condition); err != nil {
+		return err
+	}
+	if failOn == "" {
+		return nil
+	}
+	_, err := maestro.ParseCondition(failOn)
+	return err
+}
+
+// printConditionTrace prints why the condition of a failed wait was not met, if it timed out
+func printConditionTrace(result manifestwork.StatusResult) {
+	if result.Trace == nil {
+		return
+	}
+	fmt.Fprintf(os.Stderr, "Condition not met for ManifestWork %s in consumer %s:\n%s",
+		result.Name, result.Consumer, result.Trace.Tree())
+}
+
+// newStatusClient creates a client that receives ManifestWork status updates over gRPC.
+// If the gRPC connection cannot be established it falls back to an HTTP-only client,
+// in which case status is obtained by polling the HTTP API.
+func newStatusClient(ctx context.Context, config maestro.ClientConfig, log *logger.Logger) (*maestro.Client, error) {
+	client, err := maestro.NewClient(ctx, config)
+	if err == nil {
+		return client, nil
+	}
+
+	log.Warn(ctx, "Failed to connect to gRPC, falling back to HTTP polling", logger.Fields{
+		"grpc_endpoint": config.GRPCEndpoint,
+		"error":         err.Error(),
+	})
+	return maestro.NewHTTPClient(config)
+}
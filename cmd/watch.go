@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/errors"
 
 	"github.com/openshift-hyperfleet/maestro-cli/internal/maestro"
 	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
@@ -16,20 +17,7 @@ type WatchFlags struct {
 	Name         string
 	Consumer     string
 	PollInterval time.Duration
-	// Global flags
-	GRPCEndpoint        string
-	HTTPEndpoint        string
-	GRPCInsecure        bool
-	GRPCServerCAFile    string
-	GRPCClientCertFile  string
-	GRPCClientKeyFile   string
-	GRPCBrokerCAFile    string
-	GRPCClientToken     string
-	GRPCClientTokenFile string
-	ResultsPath         string
-	Output              string
-	Timeout             time.Duration
-	Verbose             bool
+	GlobalFlags
 }
 
 // NewWatchCommand creates the watch command
@@ -39,6 +27,10 @@ func NewWatchCommand() *cobra.Command {
 		Short: "Watch ManifestWork status changes",
 		Long: `Continuously watch and display ManifestWork status changes.
 
+Status changes are pushed over the gRPC CloudEvents subscription when the gRPC
+endpoint is reachable; the HTTP API is then only polled as a periodic resync.
+Without gRPC, the HTTP API is polled every --poll-interval.
+
 Examples:
   # Watch a specific ManifestWork
   maestro-cli watch --name=hyperfleet-cluster-west-1-job --consumer=agent1
@@ -49,24 +41,20 @@ Examples:
   # Watch with timeout
   maestro-cli watch --name=hyperfleet-cluster-west-1-job --consumer=agent1 --timeout=10m`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			globals, err := loadGlobalFlags(cmd)
+			if err != nil {
+				return err
+			}
+			consumer, err := globals.consumer(cmd)
+			if err != nil {
+				return err
+			}
+
 			flags := &WatchFlags{
 				Name:         getStringFlag(cmd, "name"),
-				Consumer:     getStringFlag(cmd, "consumer"),
+				Consumer:     consumer,
 				PollInterval: getDurationFlag(cmd, "poll-interval"),
-				// Global flags
-				GRPCEndpoint:        getStringFlag(cmd, "grpc-endpoint"),
-				HTTPEndpoint:        getStringFlag(cmd, "http-endpoint"),
-				GRPCInsecure:        getBoolFlag(cmd, "grpc-insecure"),
-				GRPCServerCAFile:    getStringFlag(cmd, "grpc-server-ca-file"),
-				GRPCClientCertFile:  getStringFlag(cmd, "grpc-client-cert-file"),
-				GRPCClientKeyFile:   getStringFlag(cmd, "grpc-client-key-file"),
-				GRPCBrokerCAFile:    getStringFlag(cmd, "grpc-broker-ca-file"),
-				GRPCClientToken:     getStringFlag(cmd, "grpc-client-token"),
-				GRPCClientTokenFile: getStringFlag(cmd, "grpc-client-token-file"),
-				ResultsPath:         getStringFlag(cmd, "results-path"),
-				Output:              getStringFlag(cmd, "output"),
-				Timeout:             getDurationFlag(cmd, "timeout"),
-				Verbose:             getBoolFlag(cmd, "verbose"),
+				GlobalFlags:  globals,
 			}
 
 			return runWatchCommand(cmd.Context(), flags)
@@ -75,16 +63,13 @@ Examples:
 
 	// Command-specific flags
 	cmd.Flags().String("name", "", "ManifestWork name (required)")
-	cmd.Flags().String("consumer", "", "Target cluster name (required)")
+	cmd.Flags().String("consumer", "", "Target cluster name (defaults to the consumer of the config context)")
 	cmd.Flags().Duration("poll-interval", maestro.DefaultPollInterval, "Interval between status checks")
 
 	// Mark required flags
 	if err := cmd.MarkFlagRequired("name"); err != nil {
 		panic(err)
 	}
-	if err := cmd.MarkFlagRequired("consumer"); err != nil {
-		panic(err)
-	}
 
 	return cmd
 }
@@ -94,11 +79,8 @@ func runWatchCommand(ctx context.Context, flags *WatchFlags) error {
 	// Initialize logger
 	log := logger.New(logger.Config{Level: getLogLevel(flags.Verbose), Format: "text"})
 
-	// Create HTTP-only client
-	client, err := maestro.NewHTTPClient(maestro.ClientConfig{
-		HTTPEndpoint: flags.HTTPEndpoint,
-		GRPCInsecure: flags.GRPCInsecure,
-	})
+	// Create client with gRPC status updates, falling back to HTTP-only polling
+	client, err := newStatusClient(ctx, flags.clientConfig(), log)
 	if err != nil {
 		return fmt.Errorf("failed to create Maestro client: %w", err)
 	}
@@ -121,10 +103,14 @@ func runWatchCommand(ctx context.Context, flags *WatchFlags) error {
 		defer cancel()
 	}
 
+	// Subscribe to status updates before the initial check so no change is missed
+	events := client.SubscribeWorkStatus(watchCtx, flags.Consumer, flags.Name, log)
+
 	log.Info(ctx, "Watching ManifestWork", logger.Fields{
 		"name":          flags.Name,
 		"consumer":      flags.Consumer,
 		"poll_interval": flags.PollInterval.String(),
+		"events":        events != nil,
 	})
 
 	// Track previous state to detect changes
@@ -140,7 +126,10 @@ func runWatchCommand(ctx context.Context, flags *WatchFlags) error {
 	defer ticker.Stop()
 
 	// Initial check
-	if err := printWatchStatus(watchCtx, client, flags, &lastVersion, &lastConditions); err != nil {
+	if err := pollWatchStatus(watchCtx, client, flags, &lastVersion, &lastConditions); err != nil {
+		if watchCtx.Err() == nil && isTerminalWatchError(err) {
+			return fmt.Errorf("failed to watch ManifestWork: %w", err)
+		}
 		log.Warn(ctx, "Initial status check failed", logger.Fields{"error": err.Error()})
 		consecutiveFailures++
 		updateBackoffInterval(&currentInterval, &consecutiveFailures, baseInterval, ticker)
@@ -151,8 +140,32 @@ func runWatchCommand(ctx context.Context, flags *WatchFlags) error {
 		case <-watchCtx.Done():
 			fmt.Println("\nWatch stopped")
 			return nil
+		case evt, ok := <-events:
+			if !ok {
+				// Subscription ended, resume regular HTTP polling
+				events = nil
+				baseInterval = flags.PollInterval
+				currentInterval = baseInterval
+				ticker.Reset(currentInterval)
+				log.Warn(ctx, "Status update subscription ended, falling back to HTTP polling", nil)
+				continue
+			}
+			if baseInterval < maestro.DefaultResyncInterval {
+				// Status updates are arriving over gRPC, HTTP polling only needs to resync
+				baseInterval = maestro.DefaultResyncInterval
+				currentInterval = baseInterval
+				ticker.Reset(currentInterval)
+			}
+			if evt.Deleted {
+				fmt.Printf("[%s] ManifestWork %q deleted\n", time.Now().Format("15:04:05"), flags.Name)
+				continue
+			}
+			printWatchStatus(evt.Details, &lastVersion, &lastConditions)
 		case <-ticker.C:
-			if err := printWatchStatus(watchCtx, client, flags, &lastVersion, &lastConditions); err != nil {
+			if err := pollWatchStatus(watchCtx, client, flags, &lastVersion, &lastConditions); err != nil {
+				if watchCtx.Err() == nil && isTerminalWatchError(err) {
+					return fmt.Errorf("failed to watch ManifestWork: %w", err)
+				}
 				log.Warn(ctx, "Status check failed", logger.Fields{"error": err.Error()})
 				consecutiveFailures++
 				updateBackoffInterval(&currentInterval, &consecutiveFailures, baseInterval, ticker)
@@ -169,6 +182,12 @@ func runWatchCommand(ctx context.Context, flags *WatchFlags) error {
 	}
 }
 
+// isTerminalWatchError reports whether a status check error should stop the watch
+// A missing ManifestWork is not terminal, it may be (re)created while watching
+func isTerminalWatchError(err error) bool {
+	return !maestro.IsRetryableError(err) && !errors.IsNotFound(err)
+}
+
 // updateBackoffInterval implements exponential backoff for API failures
 // Caps at 5 minutes maximum to avoid extremely long delays
 func updateBackoffInterval(
@@ -206,8 +225,8 @@ func updateBackoffInterval(
 	}
 }
 
-// printWatchStatus prints the current ManifestWork status if changed
-func printWatchStatus(
+// pollWatchStatus fetches the current ManifestWork status over HTTP and prints it if changed
+func pollWatchStatus(
 	ctx context.Context,
 	client *maestro.Client,
 	flags *WatchFlags,
@@ -219,6 +238,12 @@ func printWatchStatus(
 		return err
 	}
 
+	printWatchStatus(details, lastVersion, lastConditions)
+	return nil
+}
+
+// printWatchStatus prints the ManifestWork status if it changed since the last print
+func printWatchStatus(details *maestro.ManifestWorkDetails, lastVersion *int32, lastConditions *string) {
 	// Build current conditions string
 	var condStr string
 	for _, c := range details.Conditions {
@@ -266,6 +291,4 @@ func printWatchStatus(
 		}
 		fmt.Println()
 	}
-
-	return nil
 }
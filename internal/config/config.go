@@ -0,0 +1,175 @@
+// Package config loads and saves the maestro-cli configuration file, which holds
+// named contexts with the connection settings for different Maestro instances.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// dirName is the directory under the user config directory holding the config file
+	dirName = "maestro-cli"
+
+	// fileName is the name of the config file
+	fileName = "config.yaml"
+
+	// redactedValue replaces inline tokens in displayed config
+	redactedValue = "REDACTED"
+)
+
+// Config is the content of the maestro-cli config file
+type Config struct {
+	// CurrentContext is the name of the context used when --context is not set
+	CurrentContext string         `json:"current-context,omitempty"`
+	Contexts       []NamedContext `json:"contexts,omitempty"`
+}
+
+// NamedContext associates a name with a context
+type NamedContext struct {
+	Name    string  `json:"name"`
+	Context Context `json:"context"`
+}
+
+// Context holds the connection settings for one Maestro instance
+// Empty fields fall back to the environment variables and built-in defaults.
+type Context struct {
+	GRPCEndpoint        string `json:"grpc-endpoint,omitempty"`
+	HTTPEndpoint        string `json:"http-endpoint,omitempty"`
+	GRPCInsecure        bool   `json:"grpc-insecure,omitempty"`
+	GRPCServerCAFile    string `json:"grpc-server-ca-file,omitempty"`
+	GRPCClientCertFile  string `json:"grpc-client-cert-file,omitempty"`
+	GRPCClientKeyFile   string `json:"grpc-client-key-file,omitempty"`
+	GRPCBrokerCAFile    string `json:"grpc-broker-ca-file,omitempty"`
+	GRPCClientToken     string `json:"grpc-client-token,omitempty"`
+	GRPCClientTokenFile string `json:"grpc-client-token-file,omitempty"`
+	HTTPCAFile          string `json:"http-ca-file,omitempty"`
+	HTTPClientCertFile  string `json:"http-client-cert-file,omitempty"`
+	HTTPClientKeyFile   string `json:"http-client-key-file,omitempty"`
+	HTTPToken           string `json:"http-token,omitempty"`
+	HTTPTokenFile       string `json:"http-token-file,omitempty"`
+	SourceID            string `json:"source-id,omitempty"`
+	// Consumer is the default target cluster for commands that take --consumer
+	Consumer string `json:"consumer,omitempty"`
+}
+
+// DefaultPath returns the default config file location: $XDG_CONFIG_HOME/maestro-cli/config.yaml,
+// or ~/.config/maestro-cli/config.yaml when XDG_CONFIG_HOME is not set
+func DefaultPath() string {
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, dirName, fileName)
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return filepath.Join(".config", dirName, fileName)
+	}
+	return filepath.Join(home, ".config", dirName, fileName)
+}
+
+// Load reads the config file at path
+// A missing file is not an error and yields an empty config.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // path is provided by the user
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return &Config{}, nil
+		}
+		return nil, fmt.Errorf("failed to read config file %s: %w", path, err)
+	}
+
+	cfg := &Config{}
+	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	seen := make(map[string]bool, len(cfg.Contexts))
+	for _, named := range cfg.Contexts {
+		if named.Name == "" {
+			return nil, fmt.Errorf("invalid config file %s: context without a name", path)
+		}
+		if seen[named.Name] {
+			return nil, fmt.Errorf("invalid config file %s: duplicate context %q", path, named.Name)
+		}
+		seen[named.Name] = true
+	}
+
+	return cfg, nil
+}
+
+// Save writes the config to path, creating the parent directory if needed
+// The file is only readable by the owner since contexts may contain tokens.
+func (c *Config) Save(path string) error {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("failed to marshal config: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// Context returns the context with the given name
+func (c *Config) Context(name string) (*Context, bool) {
+	for i := range c.Contexts {
+		if c.Contexts[i].Name == name {
+			return &c.Contexts[i].Context, true
+		}
+	}
+	return nil, false
+}
+
+// SetContext adds the context, or replaces the existing context with the same name
+// Returns true if a new context was added.
+func (c *Config) SetContext(name string, ctx Context) bool {
+	if existing, ok := c.Context(name); ok {
+		*existing = ctx
+		return false
+	}
+	c.Contexts = append(c.Contexts, NamedContext{Name: name, Context: ctx})
+	sort.Slice(c.Contexts, func(i, j int) bool {
+		return c.Contexts[i].Name < c.Contexts[j].Name
+	})
+	return true
+}
+
+// Resolve returns the context to use: the named one, or the current context when name is empty
+// Returns nil without error when no context is selected.
+func (c *Config) Resolve(name string) (*Context, error) {
+	if name == "" {
+		name = c.CurrentContext
+	}
+	if name == "" {
+		return nil, nil
+	}
+
+	ctx, ok := c.Context(name)
+	if !ok {
+		return nil, fmt.Errorf("context %q not found in config", name)
+	}
+	return ctx, nil
+}
+
+// Redacted returns a copy of the config with inline tokens replaced, for display
+func (c *Config) Redacted() *Config {
+	redacted := &Config{CurrentContext: c.CurrentContext}
+	for _, named := range c.Contexts {
+		if named.Context.GRPCClientToken != "" {
+			named.Context.GRPCClientToken = redactedValue
+		}
+		if named.Context.HTTPToken != "" {
+			named.Context.HTTPToken = redactedValue
+		}
+		redacted.Contexts = append(redacted.Contexts, named)
+	}
+	return redacted
+}
@@ -0,0 +1,93 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadMissingFile(t *testing.T) {
+	cfg, err := Load(filepath.Join(t.TempDir(), "missing.yaml"))
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+	if cfg.CurrentContext != "" || len(cfg.Contexts) != 0 {
+		t.Errorf("expected empty config, got %+v", cfg)
+	}
+}
+
+func TestSaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "maestro-cli", "config.yaml")
+
+	cfg := &Config{}
+	if !cfg.SetContext("staging", Context{GRPCEndpoint: "staging:8090", Consumer: "cluster1", HTTPToken: "secret"}) {
+		t.Errorf("SetContext() expected new context to be added")
+	}
+	if cfg.SetContext("staging", Context{GRPCEndpoint: "staging:9090", Consumer: "cluster1", HTTPToken: "secret"}) {
+		t.Errorf("SetContext() expected existing context to be replaced")
+	}
+	cfg.SetContext("local", Context{GRPCInsecure: true})
+	cfg.CurrentContext = "staging"
+
+	if err := cfg.Save(path); err != nil {
+		t.Fatalf("Save() unexpected error: %v", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Stat() unexpected error: %v", err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Errorf("expected file mode 0600, got %v", info.Mode().Perm())
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+	if len(loaded.Contexts) != 2 || loaded.Contexts[0].Name != "local" {
+		t.Fatalf("expected contexts sorted by name, got %+v", loaded.Contexts)
+	}
+
+	ctx, err := loaded.Resolve("")
+	if err != nil {
+		t.Fatalf("Resolve() unexpected error: %v", err)
+	}
+	if ctx.GRPCEndpoint != "staging:9090" || ctx.Consumer != "cluster1" {
+		t.Errorf("Resolve() returned %+v, expected the current context", ctx)
+	}
+
+	if _, err := loaded.Resolve("missing"); err == nil {
+		t.Errorf("Resolve() expected error for unknown context")
+	}
+
+	redacted, _ := loaded.Redacted().Context("staging")
+	if redacted.HTTPToken != redactedValue {
+		t.Errorf("Redacted() expected token to be redacted, got %q", redacted.HTTPToken)
+	}
+	if original, _ := loaded.Context("staging"); original.HTTPToken != "secret" {
+		t.Errorf("Redacted() must not modify the original config")
+	}
+}
+
+func TestLoadRejectsInvalidConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "unknown field", content: "contexts:\n- name: a\n  context:\n    grpc-endpont: x\n"},
+		{name: "missing name", content: "contexts:\n- context:\n    grpc-endpoint: x\n"},
+		{name: "duplicate name", content: "contexts:\n- name: a\n- name: a\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := Load(path); err == nil {
+				t.Errorf("Load() expected error")
+			}
+		})
+	}
+}
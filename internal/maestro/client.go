@@ -7,10 +7,14 @@ import (
 	"crypto/x509"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"net/http"
 	"os"
+	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/openshift-online/maestro/pkg/api/openapi"
@@ -24,6 +28,7 @@ import (
 	workv1 "open-cluster-management.io/api/work/v1"
 	grpcoptions "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options/grpc"
 
+	clierrors "github.com/openshift-hyperfleet/maestro-cli/pkg/errors"
 	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
 )
 
@@ -31,6 +36,15 @@ const (
 	// DefaultPollInterval is the default interval for polling ManifestWork status
 	DefaultPollInterval = 1 * time.Second
 
+	// httpRequestTimeout bounds a single attempt of a Maestro HTTP API request
+	httpRequestTimeout = 30 * time.Second
+
+	// DefaultListPageSize is the default number of resource bundles requested per page when listing
+	DefaultListPageSize int32 = 100
+
+	// MaxListPageSize is the largest page size requested from the Maestro server when listing
+	MaxListPageSize int32 = 400
+
 	// Status constants
 	statusTrue    = "True"
 	statusApplied = "Applied"
@@ -39,9 +53,13 @@ const (
 // Client represents a Maestro client
 type Client struct {
 	workClient workv1client.WorkV1Interface // nil for HTTP-only client
+	workMu     sync.Mutex                   // serializes workClient calls, see manifestWorks
 	httpClient *openapi.APIClient
 	sourceID   string
 	cancelFunc context.CancelFunc // cancel function for gRPC context
+	bundleIDs  bundleIDCache      // resource bundle IDs of works looked up by name
+	watches    workWatches        // watches shared by the status subscriptions of each consumer
+	retry      RetryPolicy        // retry policy for gRPC calls (HTTP calls are retried by the transport)
 }
 
 // ClientConfig contains configuration for creating a Maestro client
@@ -56,6 +74,16 @@ type ClientConfig struct {
 	GRPCClientToken     string
 	GRPCClientTokenFile string
 	SourceID            string // Source ID for CloudEvents subscription (default: "maestro-cli")
+
+	// HTTP API TLS and authentication; each setting defaults to its gRPC counterpart when empty
+	HTTPCAFile         string
+	HTTPClientCertFile string
+	HTTPClientKeyFile  string
+	HTTPToken          string
+	HTTPTokenFile      string
+
+	// Retry policy for transient Maestro API failures (defaults to DefaultRetryPolicy)
+	Retry RetryPolicy
 }
 
 // NewHTTPClient creates an HTTP-only Maestro client (no gRPC connection)
@@ -67,21 +95,17 @@ func NewHTTPClient(config ClientConfig) (*Client, error) {
 		Format: "text",
 	})
 
-	// Create custom HTTP client to avoid connection issues
-	httpClient := createHTTPClient(config.GRPCInsecure, log)
-
-	// Create Maestro HTTP API client
-	maestroAPIClient := openapi.NewAPIClient(&openapi.Configuration{
-		Servers: openapi.ServerConfigurations{{
-			URL: config.HTTPEndpoint,
-		}},
-		HTTPClient: httpClient,
-	})
+	// Create Maestro HTTP API client with TLS and bearer token auth
+	maestroAPIClient, err := newAPIClient(config, log)
+	if err != nil {
+		return nil, err
+	}
 
 	return &Client{
 		workClient: nil, // No gRPC client
 		httpClient: maestroAPIClient,
 		sourceID:   "",
+		retry:      config.Retry.withDefaults(),
 	}, nil
 }
 
@@ -100,16 +124,12 @@ func NewClient(ctx context.Context, config ClientConfig) (*Client, error) {
 	// This allows us to cancel the gRPC connection on Close() or when parent context is cancelled
 	grpcCtx, cancel := context.WithCancel(ctx)
 
-	// Create custom HTTP client with proper TLS config
-	httpClient := createHTTPClient(config.GRPCInsecure, log)
-
-	// Create Maestro HTTP API client
-	maestroAPIClient := openapi.NewAPIClient(&openapi.Configuration{
-		Servers: openapi.ServerConfigurations{{
-			URL: config.HTTPEndpoint,
-		}},
-		HTTPClient: httpClient,
-	})
+	// Create Maestro HTTP API client with TLS and bearer token auth
+	maestroAPIClient, err := newAPIClient(config, log)
+	if err != nil {
+		cancel() // Clean up cancel function on error
+		return nil, err
+	}
 
 	// Create TLS config if needed
 	var tlsConfig *tls.Config
@@ -168,6 +188,7 @@ func NewClient(ctx context.Context, config ClientConfig) (*Client, error) {
 		httpClient: maestroAPIClient,
 		sourceID:   sourceID,
 		cancelFunc: cancel,
+		retry:      config.Retry.withDefaults(),
 	}, nil
 }
 
@@ -189,52 +210,140 @@ func (c *Client) Close() error {
 }
 
 // WorkClient returns the underlying work client interface
+// The work client is not safe for concurrent use across consumers; the Client methods are.
 func (c *Client) WorkClient() workv1client.WorkV1Interface {
 	return c.workClient
 }
 
+// manifestWorks calls fn with the gRPC ManifestWork client for the consumer.
+// The source work client stores the namespace of the last ManifestWorks call in shared state,
+// so calls are serialized to keep the Client safe for concurrent use across consumers.
+func (c *Client) manifestWorks(consumer string, fn func(workv1client.ManifestWorkInterface) error) error {
+	c.workMu.Lock()
+	defer c.workMu.Unlock()
+	return fn(c.workClient.ManifestWorks(consumer))
+}
+
 // SourceID returns the source ID used by this client
 func (c *Client) SourceID() string {
 	return c.sourceID
 }
 
+// newAPIClient creates the Maestro HTTP API client
+// TLS settings are applied to the transport and the bearer token is sent as the Authorization header
+func newAPIClient(config ClientConfig, log *logger.Logger) (*openapi.APIClient, error) {
+	httpClient, err := createHTTPClient(config, log)
+	if err != nil {
+		return nil, err
+	}
+
+	apiConfig := &openapi.Configuration{
+		Servers: openapi.ServerConfigurations{{
+			URL: config.HTTPEndpoint,
+		}},
+		HTTPClient:    httpClient,
+		DefaultHeader: make(map[string]string),
+	}
+	token, err := getHTTPToken(config)
+	if err != nil {
+		return nil, err
+	}
+	if token != "" {
+		apiConfig.AddDefaultHeader("Authorization", "Bearer "+token)
+	}
+
+	return openapi.NewAPIClient(apiConfig), nil
+}
+
 // createHTTPClient creates an HTTP client with proper configuration
 // to avoid connection reset issues
-func createHTTPClient(insecure bool, log *logger.Logger) *http.Client {
+// The CA and client certificate default to the gRPC values when no HTTP-specific files are configured
+func createHTTPClient(config ClientConfig, log *logger.Logger) (*http.Client, error) {
 	transport := &http.Transport{
 		DisableKeepAlives:     true, // Disable keep-alive to avoid connection reuse issues
 		MaxIdleConns:          10,
 		IdleConnTimeout:       30 * time.Second,
 		TLSHandshakeTimeout:   10 * time.Second,
+		ResponseHeaderTimeout: httpRequestTimeout,
 		ExpectContinueTimeout: 1 * time.Second,
 		ForceAttemptHTTP2:     false, // Force HTTP/1.1
 	}
 
-	if insecure {
-		transport.TLSClientConfig = &tls.Config{
-			InsecureSkipVerify: true, //nolint:gosec // This is intentional for insecure development/testing scenarios
-		}
+	tlsConfig, err := createHTTPTLSConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	if config.GRPCInsecure {
+		tlsConfig.InsecureSkipVerify = true //nolint:gosec // This is intentional for insecure development/testing scenarios
 		log.Warn(context.Background(), "TLS certificate verification disabled (insecure mode)",
 			logger.Fields{"reason": "grpc-insecure flag is set"})
 	}
+	transport.TLSClientConfig = tlsConfig
 
+	// Transient failures (timeouts, 429, 5xx) of idempotent requests are retried with backoff,
+	// so the overall timeout allows every attempt its own request timeout plus the delays between them
+	policy := config.Retry.withDefaults()
 	return &http.Client{
-		Timeout:   30 * time.Second,
-		Transport: transport,
+		Timeout: time.Duration(policy.MaxAttempts) * (httpRequestTimeout + policy.MaxBackoff),
+		Transport: &retryTransport{
+			base:   transport,
+			policy: policy,
+			log:    log,
+		},
+	}, nil
+}
+
+// createHTTPTLSConfig creates TLS configuration for the HTTP API client
+func createHTTPTLSConfig(config ClientConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	caFile := firstNonEmpty(config.HTTPCAFile, config.GRPCServerCAFile)
+	if caFile != "" {
+		caCert, err := os.ReadFile(caFile) //nolint:gosec // Path is provided by the user
+		if err != nil {
+			return nil, fmt.Errorf("failed to read HTTP CA file: %w", err)
+		}
+
+		caCertPool := x509.NewCertPool()
+		if ok := caCertPool.AppendCertsFromPEM(caCert); !ok {
+			return nil, fmt.Errorf("failed to parse HTTP CA certificate")
+		}
+		tlsConfig.RootCAs = caCertPool
+	}
+
+	// Load client certificate for mTLS; the cert and key are taken as a pair
+	certFile, keyFile := config.HTTPClientCertFile, config.HTTPClientKeyFile
+	if certFile == "" && keyFile == "" {
+		certFile, keyFile = config.GRPCClientCertFile, config.GRPCClientKeyFile
+	}
+	if certFile != "" {
+		if keyFile == "" {
+			return nil, fmt.Errorf("HTTP client key file required when client cert file is provided")
+		}
+
+		clientCert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load HTTP client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
 	}
+
+	return tlsConfig, nil
 }
 
 // ListConsumers lists all consumers from Maestro HTTP API
 func (c *Client) ListConsumers(ctx context.Context) ([]string, error) {
-	consumerList, _, err := c.httpClient.DefaultAPI.ApiMaestroV1ConsumersGet(ctx).Execute()
+	consumers, err := c.ListConsumerSummariesHTTP(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list consumers: %w", err)
+		return nil, err
 	}
 
-	names := make([]string, 0, len(consumerList.Items))
-	for _, consumer := range consumerList.Items {
-		if consumer.Name != nil {
-			names = append(names, *consumer.Name)
+	names := make([]string, 0, len(consumers))
+	for _, consumer := range consumers {
+		if consumer.Name != "" {
+			names = append(names, consumer.Name)
 		}
 	}
 	return names, nil
@@ -255,10 +364,12 @@ func (c *Client) ValidateConsumer(ctx context.Context, consumer string) error {
 
 	// Consumer not found - provide helpful error message
 	if len(consumers) == 0 {
-		return fmt.Errorf("consumer %q not found: no consumers are registered with Maestro", consumer)
+		return clierrors.NewNotFound("consumer %q not found: no consumers are registered with Maestro", consumer)
 	}
 
-	return fmt.Errorf("consumer %q not found. Available consumers: %s", consumer, strings.Join(consumers, ", "))
+	return clierrors.NewNotFound(
+		"consumer %q not found. Available consumers: %s", consumer, strings.Join(consumers, ", "),
+	)
 }
 
 // GetManifestWork retrieves a ManifestWork from Maestro using gRPC (for watch operations)
@@ -266,7 +377,13 @@ func (c *Client) GetManifestWork(ctx context.Context, consumer, name string) (*w
 	if c.workClient == nil {
 		return nil, fmt.Errorf("http client not available: GetManifestWork requires http connection")
 	}
-	return c.workClient.ManifestWorks(consumer).Get(ctx, name, metav1.GetOptions{})
+	var work *workv1.ManifestWork
+	err := c.manifestWorks(consumer, func(works workv1client.ManifestWorkInterface) error {
+		var getErr error
+		work, getErr = works.Get(ctx, name, metav1.GetOptions{})
+		return getErr
+	})
+	return work, err
 }
 
 // ListManifestWorks lists all ManifestWorks for a consumer using gRPC subscription
@@ -275,365 +392,446 @@ func (c *Client) ListManifestWorks(ctx context.Context, consumer string) (*workv
 	if c.workClient == nil {
 		return nil, fmt.Errorf("http client not available: ListManifestWorks requires http connection")
 	}
-	return c.workClient.ManifestWorks(consumer).List(ctx, metav1.ListOptions{})
+	var list *workv1.ManifestWorkList
+	err := c.manifestWorks(consumer, func(works workv1client.ManifestWorkInterface) error {
+		var listErr error
+		list, listErr = works.List(ctx, metav1.ListOptions{})
+		return listErr
+	})
+	return list, err
 }
 
 // ListManifestWorksHTTP lists all ManifestWorks for a consumer using HTTP API
 // This reads directly from the database without requiring gRPC subscription
+// All pages are fetched; use ListManifestWorksPagesHTTP to process large consumers page by page
 func (c *Client) ListManifestWorksHTTP(ctx context.Context, consumer string) ([]ResourceBundleSummary, error) {
+	var summaries []ResourceBundleSummary
+	err := c.ListManifestWorksPagesHTTP(ctx, consumer, DefaultListPageSize, func(page []ResourceBundleSummary) error {
+		summaries = append(summaries, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if summaries == nil {
+		summaries = []ResourceBundleSummary{}
+	}
+	return summaries, nil
+}
+
+// ListPageFunc is called with each page of ManifestWorks as it is received
+// Returning an error stops the iteration and is returned to the caller
+type ListPageFunc func(page []ResourceBundleSummary) error
+
+// ListManifestWorksPagesHTTP iterates over all ManifestWorks for a consumer using HTTP API,
+// requesting pageSize resource bundles at a time and calling fn for each page
+// pageSize defaults to DefaultListPageSize and is capped at MaxListPageSize
+func (c *Client) ListManifestWorksPagesHTTP(
+	ctx context.Context,
+	consumer string,
+	pageSize int32,
+	fn ListPageFunc,
+) error {
+	return c.listResourceBundlePages(ctx, consumer, pageSize, func(bundles []openapi.ResourceBundle) error {
+		summaries := make([]ResourceBundleSummary, 0, len(bundles))
+		for i := range bundles {
+			summaries = append(summaries, summaryFromBundle(consumer, &bundles[i]))
+		}
+		return fn(summaries)
+	})
+}
+
+// ListManifestWorkDetailsHTTP gets full details of all ManifestWorks for a consumer using HTTP API
+// The status of every work is read with a single (paged) list, so waits on many works poll it once.
+func (c *Client) ListManifestWorkDetailsHTTP(ctx context.Context, consumer string) ([]*ManifestWorkDetails, error) {
+	var works []*ManifestWorkDetails
+	err := c.listResourceBundlePages(ctx, consumer, DefaultListPageSize, func(bundles []openapi.ResourceBundle) error {
+		for i := range bundles {
+			name := bundleName(&bundles[i])
+			if name == "" {
+				name = getStringPtr(bundles[i].Id)
+			}
+			works = append(works, detailsFromBundle(consumer, name, &bundles[i]))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return works, nil
+}
+
+// listResourceBundlePages calls fn with each non-empty page of the resource bundles of a consumer
+func (c *Client) listResourceBundlePages(
+	ctx context.Context,
+	consumer string,
+	pageSize int32,
+	fn func(bundles []openapi.ResourceBundle) error,
+) error {
 	// Validate the consumer name to avoid SQL injection
 	if err := validateSearchQuery(consumer); err != nil {
-		return nil, fmt.Errorf("invalid consumer name: %w", err)
+		return fmt.Errorf("invalid consumer name: %w", err)
+	}
+
+	if pageSize <= 0 {
+		pageSize = DefaultListPageSize
+	}
+	if pageSize > MaxListPageSize {
+		pageSize = MaxListPageSize
 	}
 
 	// Use search parameter to filter by consumer_name
 	search := fmt.Sprintf("consumer_name = '%s'", consumer)
 
-	resourceList, _, err := c.httpClient.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).
-		Search(search).
-		Execute()
-	if err != nil {
-		return nil, fmt.Errorf("failed to list resource bundles: %w", err)
-	}
-
-	summaries := make([]ResourceBundleSummary, 0, len(resourceList.Items))
-	for _, rb := range resourceList.Items {
-		summary := ResourceBundleSummary{
-			ID:           getStringPtr(rb.Id),
-			ConsumerName: consumer,
+	for page := int32(1); ; page++ {
+		resourceList, resp, err := c.httpClient.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).
+			Search(search).
+			Page(page).
+			Size(pageSize).
+			Execute()
+		if err != nil {
+			return newAPIError(fmt.Sprintf("failed to list resource bundles (page %d)", page), resp, err)
 		}
 
-		// Get the original ManifestWork name from metadata
-		if rb.Metadata != nil {
-			if name, ok := rb.Metadata["name"].(string); ok {
-				summary.Name = name
+		if len(resourceList.Items) > 0 {
+			if err := fn(resourceList.Items); err != nil {
+				return err
 			}
 		}
-		// Fallback to ID if name not in metadata
-		if summary.Name == "" {
-			summary.Name = summary.ID
-		}
 
-		if rb.Version != nil {
-			summary.Version = *rb.Version
-		}
-		if rb.CreatedAt != nil {
-			summary.CreatedAt = rb.CreatedAt.Format(time.RFC3339)
-		}
-		if rb.UpdatedAt != nil {
-			summary.UpdatedAt = rb.UpdatedAt.Format(time.RFC3339)
+		// Stop on a short page or once every item reported by the server has been received
+		if int32(len(resourceList.Items)) < pageSize || //nolint:gosec // bounded by pageSize
+			int64(page)*int64(pageSize) >= int64(resourceList.Total) {
+			return nil
 		}
+	}
+}
 
-		// Extract manifests info (rb.Manifests is []map[string]interface{})
-		if rb.Manifests != nil {
-			summary.Manifests = make([]ManifestInfo, 0, len(rb.Manifests))
-			for _, manifest := range rb.Manifests {
-				info := ManifestInfo{}
-				if kind, ok := manifest["kind"].(string); ok {
-					info.Kind = kind
-				}
-				if metadata, ok := manifest["metadata"].(map[string]interface{}); ok {
-					if name, ok := metadata["name"].(string); ok {
-						info.Name = name
+// summaryFromBundle converts a resource bundle from the HTTP API into a ResourceBundleSummary
+func summaryFromBundle(consumer string, rb *openapi.ResourceBundle) ResourceBundleSummary {
+	summary := ResourceBundleSummary{
+		ID:           getStringPtr(rb.Id),
+		ConsumerName: consumer,
+	}
+
+	// Get the original ManifestWork name from metadata, falling back to the ID
+	summary.Name = bundleName(rb)
+	if summary.Name == "" {
+		summary.Name = summary.ID
+	}
+
+	if rb.Version != nil {
+		summary.Version = *rb.Version
+	}
+	if rb.CreatedAt != nil {
+		summary.CreatedAt = rb.CreatedAt.Format(time.RFC3339)
+	}
+	if rb.UpdatedAt != nil {
+		summary.UpdatedAt = rb.UpdatedAt.Format(time.RFC3339)
+	}
+
+	// Extract manifests info (rb.Manifests is []map[string]interface{})
+	if rb.Manifests != nil {
+		summary.Manifests = manifestInfosFromBundle(rb.Manifests)
+		summary.ManifestCount = len(summary.Manifests)
+	}
+
+	// Extract conditions from status
+	if rb.Status != nil {
+		if conditions, ok := rb.Status["conditions"].([]interface{}); ok {
+			summary.Conditions = make([]ConditionSummary, 0, len(conditions))
+			for _, c := range conditions {
+				if cond, ok := c.(map[string]interface{}); ok {
+					cs := ConditionSummary{}
+					if t, ok := cond["type"].(string); ok {
+						cs.Type = t
 					}
-					if ns, ok := metadata["namespace"].(string); ok {
-						info.Namespace = ns
+					if s, ok := cond["status"].(string); ok {
+						cs.Status = s
 					}
-				}
-				summary.Manifests = append(summary.Manifests, info)
-			}
-			summary.ManifestCount = len(summary.Manifests)
-		}
-
-		// Extract conditions from status
-		if rb.Status != nil {
-			if conditions, ok := rb.Status["conditions"].([]interface{}); ok {
-				summary.Conditions = make([]ConditionSummary, 0, len(conditions))
-				for _, c := range conditions {
-					if cond, ok := c.(map[string]interface{}); ok {
-						cs := ConditionSummary{}
-						if t, ok := cond["type"].(string); ok {
-							cs.Type = t
-						}
-						if s, ok := cond["status"].(string); ok {
-							cs.Status = s
-						}
-						if r, ok := cond["reason"].(string); ok {
-							cs.Reason = r
-						}
-						if m, ok := cond["message"].(string); ok {
-							cs.Message = m
-						}
-						summary.Conditions = append(summary.Conditions, cs)
+					if r, ok := cond["reason"].(string); ok {
+						cs.Reason = r
 					}
+					if m, ok := cond["message"].(string); ok {
+						cs.Message = m
+					}
+					summary.Conditions = append(summary.Conditions, cs)
 				}
 			}
 		}
-
-		summaries = append(summaries, summary)
 	}
 
-	return summaries, nil
+	return summary
 }
 
 // GetManifestWorkByNameHTTP looks up a ManifestWork by its original name using HTTP API
 // This reads from the database and doesn't require gRPC subscription
 func (c *Client) GetManifestWorkByNameHTTP(ctx context.Context, consumer, name string) (*ResourceBundleSummary, error) {
-	if err := validateSearchQuery(consumer); err != nil {
-		return nil, fmt.Errorf("invalid consumer name: %w", err)
-	}
-
-	// Search for resource bundle by consumer and metadata name
-	search := fmt.Sprintf("consumer_name = '%s'", consumer)
-
-	resourceList, _, err := c.httpClient.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).
-		Search(search).
-		Execute()
+	rb, err := c.findResourceBundle(ctx, consumer, name)
 	if err != nil {
-		return nil, fmt.Errorf("failed to search resource bundles: %w", err)
+		return nil, err
 	}
 
-	// Find the one with matching metadata.name
-	for _, rb := range resourceList.Items {
-		var rbName string
-		if rb.Metadata != nil {
-			if n, ok := rb.Metadata["name"].(string); ok {
-				rbName = n
-			}
-		}
-		if rbName == name {
-			summary := &ResourceBundleSummary{
-				ID:           getStringPtr(rb.Id),
-				Name:         rbName,
-				ConsumerName: consumer,
-			}
-			if rb.Version != nil {
-				summary.Version = *rb.Version
-			}
-			if rb.CreatedAt != nil {
-				summary.CreatedAt = rb.CreatedAt.Format(time.RFC3339)
-			}
-			if rb.UpdatedAt != nil {
-				summary.UpdatedAt = rb.UpdatedAt.Format(time.RFC3339)
-			}
-			// Extract manifests
-			if rb.Manifests != nil {
-				summary.Manifests = make([]ManifestInfo, 0, len(rb.Manifests))
-				for _, manifest := range rb.Manifests {
-					info := ManifestInfo{}
-					if kind, ok := manifest["kind"].(string); ok {
-						info.Kind = kind
-					}
-					if metadata, ok := manifest["metadata"].(map[string]interface{}); ok {
-						if n, ok := metadata["name"].(string); ok {
-							info.Name = n
-						}
-						if ns, ok := metadata["namespace"].(string); ok {
-							info.Namespace = ns
-						}
-					}
-					summary.Manifests = append(summary.Manifests, info)
-				}
-				summary.ManifestCount = len(summary.Manifests)
-			}
-			return summary, nil
-		}
+	summary := &ResourceBundleSummary{
+		ID:           getStringPtr(rb.Id),
+		Name:         name,
+		ConsumerName: consumer,
 	}
-
-	return nil, errors.NewNotFound(workv1.Resource("manifestwork"), name)
+	if rb.Version != nil {
+		summary.Version = *rb.Version
+	}
+	if rb.CreatedAt != nil {
+		summary.CreatedAt = rb.CreatedAt.Format(time.RFC3339)
+	}
+	if rb.UpdatedAt != nil {
+		summary.UpdatedAt = rb.UpdatedAt.Format(time.RFC3339)
+	}
+	// Extract manifests
+	if rb.Manifests != nil {
+		summary.Manifests = manifestInfosFromBundle(rb.Manifests)
+		summary.ManifestCount = len(summary.Manifests)
+	}
+	return summary, nil
 }
 
 // GetManifestWorkDetailsHTTP gets full details of a ManifestWork by name using HTTP API
 func (c *Client) GetManifestWorkDetailsHTTP(ctx context.Context, consumer, name string) (*ManifestWorkDetails, error) {
-	if err := validateSearchQuery(consumer); err != nil {
-		return nil, fmt.Errorf("invalid consumer name: %w", err)
+	rb, err := c.findResourceBundle(ctx, consumer, name)
+	if err != nil {
+		return nil, err
 	}
+	return detailsFromBundle(consumer, name, rb), nil
+}
 
-	// Search for resource bundle by consumer
-	search := fmt.Sprintf("consumer_name = '%s'", consumer)
-
-	resourceList, _, err := c.httpClient.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).
-		Search(search).
-		Execute()
+// GetManifestWorkHTTP gets a ManifestWork by name using HTTP API
+// The work is converted from its resource bundle, with the bundle version as generation, so it
+// is available even when the gRPC subscription has not received it.
+func (c *Client) GetManifestWorkHTTP(ctx context.Context, consumer, name string) (*workv1.ManifestWork, error) {
+	rb, err := c.findResourceBundle(ctx, consumer, name)
+	if err != nil {
+		return nil, err
+	}
+	if rb.Version == nil {
+		rb.Version = new(int32)
+	}
+	work, err := grpcsource.ToManifestWork(rb)
 	if err != nil {
-		return nil, fmt.Errorf("failed to search resource bundles: %w", err)
+		return nil, fmt.Errorf("failed to convert resource bundle of ManifestWork %s: %w", name, err)
 	}
+	work.Name = name
+	work.Namespace = consumer
+	return work, nil
+}
 
-	// Find the one with matching metadata.name
-	for _, rb := range resourceList.Items {
-		var rbName string
-		if rb.Metadata != nil {
-			if n, ok := rb.Metadata["name"].(string); ok {
-				rbName = n
-			}
-		}
-		if rbName != name {
-			continue
-		}
+// detailsFromBundle converts a resource bundle from the HTTP API into ManifestWorkDetails
+func detailsFromBundle(consumer, name string, rb *openapi.ResourceBundle) *ManifestWorkDetails {
+	details := &ManifestWorkDetails{
+		ID:           getStringPtr(rb.Id),
+		Name:         name,
+		ConsumerName: consumer,
+	}
 
-		details := &ManifestWorkDetails{
-			ID:           getStringPtr(rb.Id),
-			Name:         rbName,
-			ConsumerName: consumer,
+	if rb.Version != nil {
+		details.Version = *rb.Version
+	}
+	if rb.CreatedAt != nil {
+		details.CreatedAt = rb.CreatedAt.Format(time.RFC3339)
+	}
+	if rb.UpdatedAt != nil {
+		details.UpdatedAt = rb.UpdatedAt.Format(time.RFC3339)
+	}
+	if rb.Metadata != nil {
+		details.Labels = stringMap(rb.Metadata["labels"])
+		if generation, ok := rb.Metadata["generation"].(float64); ok {
+			details.Generation = int64(generation)
 		}
+	}
 
-		if rb.Version != nil {
-			details.Version = *rb.Version
+	// Extract delete option
+	if rb.DeleteOption != nil {
+		if policy, ok := rb.DeleteOption["propagationPolicy"].(string); ok {
+			details.DeleteOption = policy
 		}
-		if rb.CreatedAt != nil {
-			details.CreatedAt = rb.CreatedAt.Format(time.RFC3339)
-		}
-		if rb.UpdatedAt != nil {
-			details.UpdatedAt = rb.UpdatedAt.Format(time.RFC3339)
+	}
+
+	// Extract manifests
+	if rb.Manifests != nil {
+		details.Manifests = manifestInfosFromBundle(rb.Manifests)
+	}
+
+	// Extract conditions and resource status
+	if rb.Status != nil {
+		applyBundleStatus(details, rb.Status)
+	}
+
+	return details
+}
+
+// bundleName returns the original ManifestWork name from the metadata of a resource bundle, if any
+func bundleName(rb *openapi.ResourceBundle) string {
+	if rb.Metadata != nil {
+		if name, ok := rb.Metadata["name"].(string); ok {
+			return name
 		}
+	}
+	return ""
+}
+
+// stringMap converts a decoded JSON object such as metadata.labels into a map of strings
+func stringMap(value interface{}) map[string]string {
+	m, ok := value.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	result := make(map[string]string, len(m))
+	for k, v := range m {
+		result[k] = fmt.Sprintf("%v", v)
+	}
+	return result
+}
 
-		// Extract delete option
-		if rb.DeleteOption != nil {
-			if policy, ok := rb.DeleteOption["propagationPolicy"].(string); ok {
-				details.DeleteOption = policy
+// manifestInfosFromBundle extracts kind, name and namespace of each manifest in a resource bundle
+func manifestInfosFromBundle(manifests []map[string]interface{}) []ManifestInfo {
+	infos := make([]ManifestInfo, 0, len(manifests))
+	for _, manifest := range manifests {
+		info := ManifestInfo{}
+		if kind, ok := manifest["kind"].(string); ok {
+			info.Kind = kind
+		}
+		if metadata, ok := manifest["metadata"].(map[string]interface{}); ok {
+			if n, ok := metadata["name"].(string); ok {
+				info.Name = n
 			}
+			if ns, ok := metadata["namespace"].(string); ok {
+				info.Namespace = ns
+			}
+			info.Labels = stringMap(metadata["labels"])
 		}
+		infos = append(infos, info)
+	}
+	return infos
+}
 
-		// Extract manifests
-		if rb.Manifests != nil {
-			details.Manifests = make([]ManifestInfo, 0, len(rb.Manifests))
-			for _, manifest := range rb.Manifests {
-				info := ManifestInfo{}
-				if kind, ok := manifest["kind"].(string); ok {
-					info.Kind = kind
+// applyBundleStatus extracts ManifestWork conditions and per-resource status from a resource bundle status
+func applyBundleStatus(details *ManifestWorkDetails, status map[string]interface{}) {
+	if conditions, ok := status["conditions"].([]interface{}); ok {
+		details.Conditions = make([]ConditionSummary, 0, len(conditions))
+		for _, c := range conditions {
+			if cond, ok := c.(map[string]interface{}); ok {
+				cs := ConditionSummary{}
+				if t, ok := cond["type"].(string); ok {
+					cs.Type = t
 				}
-				if metadata, ok := manifest["metadata"].(map[string]interface{}); ok {
-					if n, ok := metadata["name"].(string); ok {
-						info.Name = n
-					}
-					if ns, ok := metadata["namespace"].(string); ok {
-						info.Namespace = ns
-					}
+				if s, ok := cond["status"].(string); ok {
+					cs.Status = s
+				}
+				if r, ok := cond["reason"].(string); ok {
+					cs.Reason = r
 				}
-				details.Manifests = append(details.Manifests, info)
+				if m, ok := cond["message"].(string); ok {
+					cs.Message = m
+				}
+				if lt, ok := cond["lastTransitionTime"].(string); ok {
+					cs.LastTransitionTime = lt
+				}
+				if og, ok := cond["observedGeneration"].(float64); ok {
+					cs.ObservedGeneration = int64(og)
+				}
+				details.Conditions = append(details.Conditions, cs)
 			}
 		}
+	}
 
-		// Extract conditions from status
-		if rb.Status != nil {
-			if conditions, ok := rb.Status["conditions"].([]interface{}); ok {
-				details.Conditions = make([]ConditionSummary, 0, len(conditions))
-				for _, c := range conditions {
-					if cond, ok := c.(map[string]interface{}); ok {
-						cs := ConditionSummary{}
-						if t, ok := cond["type"].(string); ok {
-							cs.Type = t
-						}
-						if s, ok := cond["status"].(string); ok {
-							cs.Status = s
-						}
-						if r, ok := cond["reason"].(string); ok {
-							cs.Reason = r
-						}
-						if m, ok := cond["message"].(string); ok {
-							cs.Message = m
-						}
-						if lt, ok := cond["lastTransitionTime"].(string); ok {
-							cs.LastTransitionTime = lt
-						}
-						details.Conditions = append(details.Conditions, cs)
+	// Extract resource status
+	if resourceStatus, ok := status["resourceStatus"].([]interface{}); ok {
+		details.ResourceStatus = make([]ResourceStatusInfo, 0, len(resourceStatus))
+		for _, rs := range resourceStatus {
+			if rsMap, ok := rs.(map[string]interface{}); ok {
+				rsi := ResourceStatusInfo{}
+
+				// Extract resource meta
+				if meta, ok := rsMap["resourceMeta"].(map[string]interface{}); ok {
+					if k, ok := meta["kind"].(string); ok {
+						rsi.Kind = k
+					}
+					if n, ok := meta["name"].(string); ok {
+						rsi.Name = n
+					}
+					if ns, ok := meta["namespace"].(string); ok {
+						rsi.Namespace = ns
+					}
+					if g, ok := meta["group"].(string); ok {
+						rsi.Group = g
+					}
+					if v, ok := meta["version"].(string); ok {
+						rsi.Version = v
+					}
+					if r, ok := meta["resource"].(string); ok {
+						rsi.Resource = r
 					}
 				}
-			}
 
-			// Extract resource status
-			if resourceStatus, ok := rb.Status["resourceStatus"].([]interface{}); ok {
-				details.ResourceStatus = make([]ResourceStatusInfo, 0, len(resourceStatus))
-				for _, rs := range resourceStatus {
-					if rsMap, ok := rs.(map[string]interface{}); ok {
-						rsi := ResourceStatusInfo{}
-
-						// Extract resource meta
-						if meta, ok := rsMap["resourceMeta"].(map[string]interface{}); ok {
-							if k, ok := meta["kind"].(string); ok {
-								rsi.Kind = k
+				// Extract conditions
+				if conds, ok := rsMap["conditions"].([]interface{}); ok {
+					rsi.Conditions = make([]ConditionSummary, 0, len(conds))
+					for _, c := range conds {
+						if condMap, ok := c.(map[string]interface{}); ok {
+							cs := ConditionSummary{}
+							if t, ok := condMap["type"].(string); ok {
+								cs.Type = t
 							}
-							if n, ok := meta["name"].(string); ok {
-								rsi.Name = n
+							if s, ok := condMap["status"].(string); ok {
+								cs.Status = s
 							}
-							if ns, ok := meta["namespace"].(string); ok {
-								rsi.Namespace = ns
+							if r, ok := condMap["reason"].(string); ok {
+								cs.Reason = r
 							}
-							if g, ok := meta["group"].(string); ok {
-								rsi.Group = g
+							if m, ok := condMap["message"].(string); ok {
+								cs.Message = m
 							}
-							if v, ok := meta["version"].(string); ok {
-								rsi.Version = v
-							}
-							if r, ok := meta["resource"].(string); ok {
-								rsi.Resource = r
-							}
-						}
-
-						// Extract conditions
-						if conds, ok := rsMap["conditions"].([]interface{}); ok {
-							rsi.Conditions = make([]ConditionSummary, 0, len(conds))
-							for _, c := range conds {
-								if condMap, ok := c.(map[string]interface{}); ok {
-									cs := ConditionSummary{}
-									if t, ok := condMap["type"].(string); ok {
-										cs.Type = t
-									}
-									if s, ok := condMap["status"].(string); ok {
-										cs.Status = s
-									}
-									if r, ok := condMap["reason"].(string); ok {
-										cs.Reason = r
-									}
-									if m, ok := condMap["message"].(string); ok {
-										cs.Message = m
-									}
-									rsi.Conditions = append(rsi.Conditions, cs)
-								}
+							if og, ok := condMap["observedGeneration"].(float64); ok {
+								cs.ObservedGeneration = int64(og)
 							}
+							rsi.Conditions = append(rsi.Conditions, cs)
 						}
+					}
+				}
 
-						// Extract status feedback
-						if feedback, ok := rsMap["statusFeedback"].(map[string]interface{}); ok {
-							if values, ok := feedback["values"].([]interface{}); ok && len(values) > 0 {
-								rsi.StatusFeedback = make(map[string]interface{})
-								for _, v := range values {
-									if valMap, ok := v.(map[string]interface{}); ok {
-										if name, ok := valMap["name"].(string); ok {
-											if fv, ok := valMap["fieldValue"].(map[string]interface{}); ok {
-												if strVal, ok := fv["string"].(string); ok {
-													rsi.StatusFeedback[name] = strVal
-												} else if intVal, ok := fv["integer"].(float64); ok {
-													rsi.StatusFeedback[name] = int64(intVal)
-												} else if boolVal, ok := fv["boolean"].(bool); ok {
-													rsi.StatusFeedback[name] = boolVal
-												} else if jsonRaw, ok := fv["jsonRaw"].(string); ok {
-													// Parse JSON raw string into interface{}
-													var parsed interface{}
-													if err := json.Unmarshal([]byte(jsonRaw), &parsed); err == nil {
-														rsi.StatusFeedback[name] = parsed
-													} else {
-														// If parsing fails, store as raw string
-														rsi.StatusFeedback[name] = jsonRaw
-													}
-												}
+				// Extract status feedback
+				if feedback, ok := rsMap["statusFeedback"].(map[string]interface{}); ok {
+					if values, ok := feedback["values"].([]interface{}); ok && len(values) > 0 {
+						rsi.StatusFeedback = make(map[string]interface{})
+						for _, v := range values {
+							if valMap, ok := v.(map[string]interface{}); ok {
+								if name, ok := valMap["name"].(string); ok {
+									if fv, ok := valMap["fieldValue"].(map[string]interface{}); ok {
+										if strVal, ok := fv["string"].(string); ok {
+											rsi.StatusFeedback[name] = strVal
+										} else if intVal, ok := fv["integer"].(float64); ok {
+											rsi.StatusFeedback[name] = int64(intVal)
+										} else if boolVal, ok := fv["boolean"].(bool); ok {
+											rsi.StatusFeedback[name] = boolVal
+										} else if jsonRaw, ok := fv["jsonRaw"].(string); ok {
+											// Parse JSON raw string into interface{}
+											var parsed interface{}
+											if err := json.Unmarshal([]byte(jsonRaw), &parsed); err == nil {
+												rsi.StatusFeedback[name] = parsed
+											} else {
+												// If parsing fails, store as raw string
+												rsi.StatusFeedback[name] = jsonRaw
 											}
 										}
 									}
 								}
 							}
 						}
-
-						details.ResourceStatus = append(details.ResourceStatus, rsi)
 					}
 				}
+
+				details.ResourceStatus = append(details.ResourceStatus, rsi)
 			}
 		}
-
-		return details, nil
 	}
-
-	return nil, errors.NewNotFound(workv1.Resource("manifestwork"), name)
 }
 
 // DeleteManifestWorkByNameHTTP deletes a ManifestWork by its original name using HTTP API
@@ -646,9 +844,9 @@ func (c *Client) DeleteManifestWorkByNameHTTP(ctx context.Context, consumer, nam
 	}
 
 	// Delete by ID using HTTP API
-	_, err = c.httpClient.DefaultAPI.ApiMaestroV1ResourceBundlesIdDelete(ctx, work.ID).Execute()
+	resp, err := c.httpClient.DefaultAPI.ApiMaestroV1ResourceBundlesIdDelete(ctx, work.ID).Execute()
 	if err != nil {
-		return fmt.Errorf("failed to delete resource bundle %s: %w", work.ID, err)
+		return newAPIError(fmt.Sprintf("failed to delete resource bundle %s", work.ID), resp, err)
 	}
 
 	return nil
@@ -664,9 +862,10 @@ func getStringPtr(s *string) string {
 
 // ManifestInfo represents basic info about a manifest within a ManifestWork
 type ManifestInfo struct {
-	Kind      string `json:"kind" yaml:"kind"`
-	Name      string `json:"name" yaml:"name"`
-	Namespace string `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	Kind      string            `json:"kind" yaml:"kind"`
+	Name      string            `json:"name" yaml:"name"`
+	Namespace string            `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
 }
 
 // String returns a formatted string for the manifest
@@ -684,6 +883,8 @@ type ConditionSummary struct {
 	Reason             string `json:"reason,omitempty" yaml:"reason,omitempty"`
 	Message            string `json:"message,omitempty" yaml:"message,omitempty"`
 	LastTransitionTime string `json:"lastTransitionTime,omitempty" yaml:"lastTransitionTime,omitempty"`
+	// ObservedGeneration is the ManifestWork generation the agent had applied when it set the condition
+	ObservedGeneration int64 `json:"observedGeneration,omitempty" yaml:"observedGeneration,omitempty"`
 }
 
 // ResourceStatusInfo represents the status of a specific resource in the ManifestWork
@@ -703,7 +904,9 @@ type ManifestWorkDetails struct {
 	ID             string               `json:"id" yaml:"id"`
 	Name           string               `json:"name" yaml:"name"`
 	ConsumerName   string               `json:"consumerName" yaml:"consumerName"`
+	Labels         map[string]string    `json:"labels,omitempty" yaml:"labels,omitempty"`
 	Version        int32                `json:"version" yaml:"version"`
+	Generation     int64                `json:"generation,omitempty" yaml:"generation,omitempty"`
 	CreatedAt      string               `json:"createdAt" yaml:"createdAt"`
 	UpdatedAt      string               `json:"updatedAt" yaml:"updatedAt"`
 	Manifests      []ManifestInfo       `json:"manifests" yaml:"manifests"`
@@ -712,6 +915,14 @@ type ManifestWorkDetails struct {
 	DeleteOption   string               `json:"deleteOption,omitempty" yaml:"deleteOption,omitempty"`
 }
 
+// generation returns the generation of the ManifestWork, which Maestro tracks as the resource bundle version
+func (d *ManifestWorkDetails) generation() int64 {
+	if d.Generation > 0 {
+		return d.Generation
+	}
+	return int64(d.Version)
+}
+
 // ResourceBundleSummary represents a summary of a resource bundle from HTTP API
 type ResourceBundleSummary struct {
 	ID            string             `json:"id" yaml:"id"`
@@ -727,9 +938,9 @@ type ResourceBundleSummary struct {
 
 // GetResourceBundleHTTP gets a single resource bundle by ID using the HTTP API
 func (c *Client) GetResourceBundleHTTP(ctx context.Context, id string) (*openapi.ResourceBundle, error) {
-	resource, _, err := c.httpClient.DefaultAPI.ApiMaestroV1ResourceBundlesIdGet(ctx, id).Execute()
+	resource, resp, err := c.httpClient.DefaultAPI.ApiMaestroV1ResourceBundlesIdGet(ctx, id).Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get resource bundle: %w", err)
+		return nil, newAPIError("failed to get resource bundle", resp, err)
 	}
 	return resource, nil
 }
@@ -750,11 +961,11 @@ func (c *Client) GetResourceBundleByNameHTTP(
 	// Search by name and consumer_name
 	search := fmt.Sprintf("name = '%s' and consumer_name = '%s'", name, consumer)
 
-	resourceList, _, err := c.httpClient.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).
+	resourceList, resp, err := c.httpClient.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).
 		Search(search).
 		Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to search resource bundles: %w", err)
+		return nil, newAPIError("failed to search resource bundles", resp, err)
 	}
 
 	if len(resourceList.Items) == 0 {
@@ -779,70 +990,48 @@ type ResourceBundleFull struct {
 
 // GetResourceBundleFullHTTP gets a full resource bundle by name and consumer for output
 func (c *Client) GetResourceBundleFullHTTP(ctx context.Context, consumer, name string) (*ResourceBundleFull, error) {
-	if err := validateSearchQuery(consumer); err != nil {
-		return nil, fmt.Errorf("invalid consumer name: %w", err)
+	rb, err := c.findResourceBundle(ctx, consumer, name)
+	if err != nil {
+		return nil, err
 	}
 
-	// Search for resource bundle by consumer
-	search := fmt.Sprintf("consumer_name = '%s'", consumer)
+	result := &ResourceBundleFull{
+		ID:           getStringPtr(rb.Id),
+		Name:         name,
+		ConsumerName: consumer,
+	}
 
-	resourceList, _, err := c.httpClient.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).
-		Search(search).
-		Execute()
-	if err != nil {
-		return nil, fmt.Errorf("failed to search resource bundles: %w", err)
+	if rb.Version != nil {
+		result.Version = *rb.Version
+	}
+	if rb.CreatedAt != nil {
+		result.CreatedAt = rb.CreatedAt.Format(time.RFC3339)
+	}
+	if rb.UpdatedAt != nil {
+		result.UpdatedAt = rb.UpdatedAt.Format(time.RFC3339)
+	}
+	if rb.DeleteOption != nil {
+		result.DeleteOption = rb.DeleteOption
+	}
+	if rb.Manifests != nil {
+		result.Manifests = rb.Manifests
+	}
+	if rb.Status != nil {
+		result.Status = rb.Status
 	}
 
-	// Find the one with matching metadata.name
-	for _, rb := range resourceList.Items {
-		var rbName string
-		if rb.Metadata != nil {
-			if n, ok := rb.Metadata["name"].(string); ok {
-				rbName = n
-			}
-		}
-		if rbName != name {
-			continue
-		}
+	return result, nil
+}
 
-		result := &ResourceBundleFull{
-			ID:           getStringPtr(rb.Id),
-			Name:         rbName,
-			ConsumerName: consumer,
-		}
-
-		if rb.Version != nil {
-			result.Version = *rb.Version
-		}
-		if rb.CreatedAt != nil {
-			result.CreatedAt = rb.CreatedAt.Format(time.RFC3339)
-		}
-		if rb.UpdatedAt != nil {
-			result.UpdatedAt = rb.UpdatedAt.Format(time.RFC3339)
-		}
-		if rb.DeleteOption != nil {
-			result.DeleteOption = rb.DeleteOption
-		}
-		if rb.Manifests != nil {
-			result.Manifests = rb.Manifests
-		}
-		if rb.Status != nil {
-			result.Status = rb.Status
-		}
-
-		return result, nil
-	}
-
-	return nil, errors.NewNotFound(workv1.Resource("manifestwork"), name)
-}
-
-// DeleteManifestWork deletes a ManifestWork from the target consumer
-func (c *Client) DeleteManifestWork(ctx context.Context, consumer, name string) error {
-	if c.workClient == nil {
-		return fmt.Errorf("gRPC client not available: DeleteManifestWork requires gRPC connection")
-	}
-	return c.workClient.ManifestWorks(consumer).Delete(ctx, name, metav1.DeleteOptions{})
-}
+// DeleteManifestWork deletes a ManifestWork from the target consumer
+func (c *Client) DeleteManifestWork(ctx context.Context, consumer, name string) error {
+	if c.workClient == nil {
+		return fmt.Errorf("gRPC client not available: DeleteManifestWork requires gRPC connection")
+	}
+	return c.manifestWorks(consumer, func(works workv1client.ManifestWorkInterface) error {
+		return works.Delete(ctx, name, metav1.DeleteOptions{})
+	})
+}
 
 // UpdateManifestWork updates an existing ManifestWork
 func (c *Client) UpdateManifestWork(
@@ -854,7 +1043,13 @@ func (c *Client) UpdateManifestWork(
 		return nil, fmt.Errorf("gRPC client not available: UpdateManifestWork requires gRPC connection")
 	}
 	manifestWork.Namespace = consumer
-	return c.workClient.ManifestWorks(consumer).Update(ctx, manifestWork, metav1.UpdateOptions{})
+	var updated *workv1.ManifestWork
+	err := c.manifestWorks(consumer, func(works workv1client.ManifestWorkInterface) error {
+		var updateErr error
+		updated, updateErr = works.Update(ctx, manifestWork, metav1.UpdateOptions{})
+		return updateErr
+	})
+	return updated, err
 }
 
 // PatchManifestWork updates an existing ManifestWork using patch (supports gRPC)
@@ -881,8 +1076,13 @@ func (c *Client) PatchManifestWork(
 		"resource_version": existingWork.ResourceVersion,
 	})
 
-	return c.workClient.ManifestWorks(consumer).
-		Patch(ctx, updatedWork.Name, types.MergePatchType, patchData, metav1.PatchOptions{})
+	var patched *workv1.ManifestWork
+	err = c.manifestWorks(consumer, func(works workv1client.ManifestWorkInterface) error {
+		var patchErr error
+		patched, patchErr = works.Patch(ctx, updatedWork.Name, types.MergePatchType, patchData, metav1.PatchOptions{})
+		return patchErr
+	})
+	return patched, err
 }
 
 // ManifestWorkExists checks if a ManifestWork exists
@@ -890,7 +1090,7 @@ func (c *Client) ManifestWorkExists(ctx context.Context, consumer, name string)
 	if c.workClient == nil {
 		return false, fmt.Errorf("gRPC client not available: ManifestWorkExists requires gRPC connection")
 	}
-	_, err := c.workClient.ManifestWorks(consumer).Get(ctx, name, metav1.GetOptions{})
+	_, err := c.GetManifestWork(ctx, consumer, name)
 	if errors.IsNotFound(err) {
 		return false, nil
 	}
@@ -900,19 +1100,79 @@ func (c *Client) ManifestWorkExists(ctx context.Context, consumer, name string)
 	return true, nil
 }
 
+// Operations performed by ApplyManifestWork
+const (
+	ApplyOperationCreated   = "Created"
+	ApplyOperationUpdated   = "Updated"
+	ApplyOperationUnchanged = "Unchanged"
+)
+
+// ApplyResult is the ManifestWork returned by ApplyManifestWork and the operation performed
+type ApplyResult struct {
+	*workv1.ManifestWork
+	Operation string // Created, Updated, Recreated or Unchanged
+	// Previous is the work before an update or recreate, used to roll the apply back
+	Previous *workv1.ManifestWork
+}
+
 // ApplyManifestWork applies a ManifestWork to the target consumer
+// A work whose labels, annotations and spec already match the existing work is left untouched
+// and reported as Unchanged.
 func (c *Client) ApplyManifestWork(
 	ctx context.Context,
 	consumer string,
 	manifestWork *workv1.ManifestWork,
 	log *logger.Logger,
-) (*workv1.ManifestWork, error) {
+) (*ApplyResult, error) {
+	return c.ApplyManifestWorkWithOptions(ctx, consumer, manifestWork, ApplyOptions{}, log)
+}
+
+// ApplyOptions control ApplyManifestWorkWithOptions
+type ApplyOptions struct {
+	Preconditions ApplyPreconditions
+	Force         bool // Update the work even when its spec hash and metadata are unchanged
+}
+
+// ApplyManifestWorkWithOptions applies a ManifestWork like ApplyManifestWork
+// When preconditions are set they are checked first, and the update is based on the checked
+// version, so a concurrent change between the check and the update is reported as a conflict too.
+func (c *Client) ApplyManifestWorkWithOptions(
+	ctx context.Context,
+	consumer string,
+	manifestWork *workv1.ManifestWork,
+	opts ApplyOptions,
+	log *logger.Logger,
+) (*ApplyResult, error) {
+	if opts.Preconditions.IsZero() {
+		return c.applyManifestWork(ctx, consumer, manifestWork, nil, opts.Force, log)
+	}
+
+	version, err := c.CheckApplyPreconditions(ctx, consumer, manifestWork.Name, opts.Preconditions)
+	if err != nil {
+		return nil, err
+	}
+	return c.applyManifestWork(ctx, consumer, manifestWork, &version, opts.Force, log)
+}
+
+// applyManifestWork implements ApplyManifestWorkWithOptions
+// When baseVersion is set, the existing work must still have that version (0: must not exist).
+func (c *Client) applyManifestWork(
+	ctx context.Context,
+	consumer string,
+	manifestWork *workv1.ManifestWork,
+	baseVersion *int32,
+	force bool,
+	log *logger.Logger,
+) (*ApplyResult, error) {
 	if c.workClient == nil {
 		return nil, fmt.Errorf("gRPC client not available: ApplyManifestWork requires gRPC connection")
 	}
 
 	// Set the namespace to the consumer name (this is how Maestro routing works)
 	manifestWork.Namespace = consumer
+	if err := setSpecHash(manifestWork); err != nil {
+		return nil, err
+	}
 
 	// Check if ManifestWork exists using HTTP API (reliable, reads from DB)
 	existingSummary, err := c.GetManifestWorkByNameHTTP(ctx, consumer, manifestWork.Name)
@@ -920,25 +1180,60 @@ func (c *Client) ApplyManifestWork(
 		return nil, fmt.Errorf("failed to check existing work: %w", err)
 	}
 
+	if baseVersion != nil && (existingSummary == nil) != (*baseVersion == 0) {
+		return nil, &VersionConflictError{
+			Name:   manifestWork.Name,
+			Reason: fmt.Sprintf("created or deleted since version %d was checked", *baseVersion),
+		}
+	}
+
 	if existingSummary == nil {
 		// Work doesn't exist, create it
 		log.Info(ctx, "Creating new ManifestWork", logger.Fields{
 			"manifest_name": manifestWork.Name,
 			"consumer":      consumer,
 		})
-		return c.workClient.ManifestWorks(consumer).Create(ctx, manifestWork, metav1.CreateOptions{})
+		return c.applyCreate(ctx, consumer, manifestWork, ApplyOperationCreated, nil)
 	}
 
 	// Work exists - get it via gRPC for update (now subscription should have it after checking HTTP)
-	existingWork, err := c.workClient.ManifestWorks(consumer).Get(ctx, manifestWork.Name, metav1.GetOptions{})
+	existingWork, err := c.GetManifestWork(ctx, consumer, manifestWork.Name)
 	if err != nil {
-		// If gRPC can't find it but HTTP found it, fall back to create (replace)
-		log.Info(ctx, "ManifestWork exists in DB but not in subscription, recreating", logger.Fields{
+		// If gRPC can't find it but HTTP found it, replace it with a create. The stored work is
+		// reported as the previous one, so the replacement can be rolled back like an update.
+		previous, httpErr := c.GetManifestWorkHTTP(ctx, consumer, manifestWork.Name)
+		if httpErr != nil {
+			return nil, fmt.Errorf("failed to get existing work: %w", httpErr)
+		}
+		log.Info(ctx, "ManifestWork exists in DB but not in subscription, replacing it", logger.Fields{
+			"manifest_name":      manifestWork.Name,
+			"consumer":           consumer,
+			"existing_id":        existingSummary.ID,
+			"current_generation": previous.Generation,
+		})
+		return c.applyCreate(ctx, consumer, manifestWork, ApplyOperationUpdated, previous)
+	}
+
+	// The update is sent based on this version, so Maestro rejects it if the work changed since
+	if baseVersion != nil && existingWork.Generation != int64(*baseVersion) {
+		return nil, &VersionConflictError{
+			Name: manifestWork.Name,
+			Reason: fmt.Sprintf("version %d was checked but version %d is current",
+				*baseVersion, existingWork.Generation),
+		}
+	}
+
+	changed, err := manifestWorkChanged(existingWork, manifestWork)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare with existing work: %w", err)
+	}
+	if !changed && !force {
+		log.Info(ctx, "ManifestWork unchanged", logger.Fields{
 			"manifest_name": manifestWork.Name,
 			"consumer":      consumer,
-			"existing_id":   existingSummary.ID,
+			"generation":    existingWork.Generation,
 		})
-		return c.workClient.ManifestWorks(consumer).Create(ctx, manifestWork, metav1.CreateOptions{})
+		return &ApplyResult{ManifestWork: existingWork, Operation: ApplyOperationUnchanged}, nil
 	}
 
 	// Work exists, update with merge patch
@@ -954,17 +1249,112 @@ func (c *Client) ApplyManifestWork(
 		return nil, fmt.Errorf("failed to create patch: %w", err)
 	}
 
-	return c.workClient.ManifestWorks(consumer).
-		Patch(ctx, manifestWork.Name, types.MergePatchType, patchData, metav1.PatchOptions{})
+	var patched *workv1.ManifestWork
+	err = c.retry.Do(ctx, func() error {
+		return c.manifestWorks(consumer, func(works workv1client.ManifestWorkInterface) error {
+			var patchErr error
+			patched, patchErr = works.Patch(ctx, manifestWork.Name, types.MergePatchType, patchData, metav1.PatchOptions{})
+			return patchErr
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ApplyResult{ManifestWork: patched, Operation: ApplyOperationUpdated, Previous: existingWork}, nil
+}
+
+// applyCreate creates the ManifestWork and reports it with the given operation and previous work
+func (c *Client) applyCreate(
+	ctx context.Context,
+	consumer string,
+	manifestWork *workv1.ManifestWork,
+	operation string,
+	previous *workv1.ManifestWork,
+) (*ApplyResult, error) {
+	created, err := c.createManifestWork(ctx, consumer, manifestWork)
+	if err != nil {
+		return nil, err
+	}
+	return &ApplyResult{ManifestWork: created, Operation: operation, Previous: previous}, nil
+}
+
+// manifestWorkChanged reports whether applying desired would change the labels, annotations or spec of existing
+// When existing carries a SpecHashAnnotation the spec hashes are compared; otherwise the specs are
+// compared as JSON values, so formatting and key order of the raw manifests do not matter.
+func manifestWorkChanged(existing, desired *workv1.ManifestWork) (bool, error) {
+	if !maps.Equal(existing.Labels, desired.Labels) ||
+		!maps.Equal(withoutSpecHash(existing.Annotations), withoutSpecHash(desired.Annotations)) {
+		return true, nil
+	}
+
+	if existingHash, ok := existing.Annotations[SpecHashAnnotation]; ok {
+		desiredHash, err := SpecHash(desired.Spec)
+		if err != nil {
+			return false, err
+		}
+		return existingHash != desiredHash, nil
+	}
+
+	existingSpec, err := normalizedJSON(existing.Spec)
+	if err != nil {
+		return false, err
+	}
+	desiredSpec, err := normalizedJSON(desired.Spec)
+	if err != nil {
+		return false, err
+	}
+	return !reflect.DeepEqual(existingSpec, desiredSpec), nil
+}
+
+// normalizedJSON round-trips v through JSON into generic maps and slices
+func normalizedJSON(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var normalized interface{}
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return nil, err
+	}
+	return normalized, nil
+}
+
+// createManifestWork publishes a new ManifestWork over gRPC, retrying transient failures
+// Create is not idempotent: an attempt can publish the work and still fail, so a retry that finds
+// the work already exists counts as success and returns the published work.
+func (c *Client) createManifestWork(
+	ctx context.Context,
+	consumer string,
+	manifestWork *workv1.ManifestWork,
+) (*workv1.ManifestWork, error) {
+	var created *workv1.ManifestWork
+	attempt := 0
+	err := c.retry.Do(ctx, func() error {
+		attempt++
+		return c.manifestWorks(consumer, func(works workv1client.ManifestWorkInterface) error {
+			var createErr error
+			created, createErr = works.Create(ctx, manifestWork, metav1.CreateOptions{})
+			if attempt > 1 && errors.IsAlreadyExists(createErr) {
+				created, createErr = works.Get(ctx, manifestWork.Name, metav1.GetOptions{})
+			}
+			return createErr
+		})
+	})
+	return created, err
 }
 
 // WaitCallback is called on each poll with current ManifestWork details
 // Return true to continue waiting, false to stop
 type WaitCallback func(details *ManifestWorkDetails, conditionMet bool) error
 
-// WaitForCondition polls for a ManifestWork condition expression using HTTP API
-// Supports logical expressions like "Available AND Job:Complete" or "Job:succeeded>=1 OR Job:Failed"
-// The optional callback is invoked on each poll to report progress
+// WaitForCondition waits for a ManifestWork condition expression to become true
+// Supports logical expressions like "Available AND Job:Complete" or "Job:succeeded>=1 OR NOT Job:Failed"
+// The expression is compiled once before polling, so syntax errors are returned immediately.
+//
+// When the client has a gRPC connection, status updates received over the CloudEvents subscription
+// are evaluated as they arrive and HTTP polling is reduced to a DefaultResyncInterval resync.
+// Without gRPC, or until the first status update arrives, the HTTP API is polled every pollInterval.
+// The optional callback is invoked on each evaluation to report progress
 func (c *Client) WaitForCondition(
 	ctx context.Context,
 	consumer, workName, conditionExpr string,
@@ -972,26 +1362,94 @@ func (c *Client) WaitForCondition(
 	log *logger.Logger,
 	callback WaitCallback,
 ) error {
+	return c.WaitForConditionWithOptions(
+		ctx, consumer, workName, conditionExpr, WaitOptions{PollInterval: pollInterval}, log, callback)
+}
+
+// WaitOptions control how WaitForConditionWithOptions waits
+type WaitOptions struct {
+	// PollInterval is the HTTP polling interval without status updates (DefaultPollInterval if zero)
+	PollInterval time.Duration
+	// FailOn is a condition expression that ends the wait with a ConditionFailedError when it becomes true
+	FailOn string
+	// StableFor requires the whole condition to hold continuously for this long (--stable-for)
+	StableFor time.Duration
+	// Mode is WaitModeAll (default) or WaitModeAny, for waits on several ManifestWorks (WaitForWorks)
+	Mode string
+}
+
+// WaitForConditionWithOptions waits like WaitForCondition and additionally stops as soon as the FailOn
+// expression becomes true. FailOn is evaluated before the awaited condition, so a status matching both
+// fails the wait; the callback still receives that status, with conditionMet false.
+// When ctx ends first, a ConditionUnmetError explains the condition against the last status received.
+func (c *Client) WaitForConditionWithOptions(
+	ctx context.Context,
+	consumer, workName, conditionExpr string,
+	opts WaitOptions,
+	log *logger.Logger,
+	callback WaitCallback,
+) error {
+	condition, err := ParseCondition(conditionExpr)
+	if err != nil {
+		return err
+	}
+	if opts.StableFor > 0 {
+		condition.stableFor(opts.StableFor)
+	}
+	var failOn *Condition
+	if opts.FailOn != "" {
+		if failOn, err = ParseCondition(opts.FailOn); err != nil {
+			return err
+		}
+	}
+
+	pollInterval := opts.PollInterval
 	if pollInterval == 0 {
 		pollInterval = DefaultPollInterval
 	}
 
+	// Subscribe before the first HTTP check so no status update is missed in between
+	subCtx, cancelSub := context.WithCancel(ctx)
+	defer cancelSub()
+	events := c.SubscribeWorkStatus(subCtx, consumer, workName, log)
+
+	// evaluate checks the fail-on and awaited conditions against the latest details and reports progress
+	// While a stability window is running, recheck fires when it completes.
+	var recheck <-chan time.Time
+	evaluate := func(details *ManifestWorkDetails) (bool, error) {
+		matched, failed := failOn.Match(ctx, details, log)
+		conditionMet := !failed && condition.Evaluate(ctx, details, log)
+		recheck = nil
+		if until := earliest(condition.pendingUntil(), failOn.pendingUntil()); !until.IsZero() {
+			recheck = time.After(time.Until(until))
+		}
+		if callback != nil {
+			if err := callback(details, conditionMet); err != nil {
+				log.Warn(ctx, "Callback error (results may not be written)", logger.Fields{"error": err.Error()})
+			}
+		}
+		if failed {
+			log.Warn(ctx, "Fail-on condition met", logger.Fields{
+				"fail_on": opts.FailOn,
+				"matched": matched,
+				"name":    workName,
+			})
+			return false, &ConditionFailedError{Name: workName, FailOn: opts.FailOn, Matched: matched}
+		}
+		return conditionMet, nil
+	}
+
 	// First check current status using HTTP API
 	details, err := c.GetManifestWorkDetailsHTTP(ctx, consumer, workName)
 	if err != nil {
 		return fmt.Errorf("failed to get ManifestWork: %w", err)
 	}
 
-	conditionMet := evaluateConditionExpression(ctx, details, conditionExpr, log)
-
-	// Call callback with initial status
-	if callback != nil {
-		if err := callback(details, conditionMet); err != nil {
-			log.Warn(ctx, "Callback error (results may not be written)", logger.Fields{"error": err.Error()})
-		}
+	met, err := evaluate(details)
+	if err != nil {
+		return err
 	}
-
-	if conditionMet {
+	if met {
 		log.Info(ctx, "Condition already met", logger.Fields{
 			"condition": conditionExpr,
 			"name":      workName,
@@ -1003,10 +1461,22 @@ func (c *Client) WaitForCondition(
 		"name":          workName,
 		"condition":     conditionExpr,
 		"poll_interval": pollInterval.String(),
+		"events":        events != nil,
 	})
 
+	// unmet explains why the condition was not met by the last status when ctx ends
+	unmet := func() error {
+		return &ConditionUnmetError{
+			Name:      workName,
+			Condition: conditionExpr,
+			Trace:     condition.Trace(ctx, details, log),
+			Err:       ctx.Err(),
+		}
+	}
+
 	ticker := time.NewTicker(pollInterval)
 	defer ticker.Stop()
+	eventDriven := false
 
 	for {
 		select {
@@ -1015,42 +1485,66 @@ func (c *Client) WaitForCondition(
 				"condition": conditionExpr,
 				"error":     ctx.Err().Error(),
 			})
-			return ctx.Err()
+			return unmet()
+		case evt, ok := <-events:
+			if !ok {
+				events = nil
+				eventDriven = false
+				ticker.Reset(pollInterval)
+				log.Warn(ctx, "Status update subscription ended, falling back to HTTP polling", nil)
+				continue
+			}
+			eventDriven = switchToResync(ctx, eventDriven, ticker, log)
+			if evt.Deleted {
+				log.Warn(ctx, "ManifestWork was deleted while waiting for condition", logger.Fields{
+					"name": workName,
+				})
+				continue
+			}
+			details = evt.Details
 		case <-ticker.C:
-			details, err := c.GetManifestWorkDetailsHTTP(ctx, consumer, workName)
+			polled, err := c.GetManifestWorkDetailsHTTP(ctx, consumer, workName)
 			if err != nil {
+				// The poll failed because ctx ended while it was in flight
+				if ctx.Err() != nil {
+					return unmet()
+				}
+				// Terminal errors (e.g. unauthorized, not found) will not resolve by polling again
+				if !IsRetryableError(err) {
+					return fmt.Errorf("failed to poll ManifestWork: %w", err)
+				}
 				log.Warn(ctx, "Failed to poll ManifestWork", logger.Fields{
 					"error": err.Error(),
 				})
 				continue
 			}
+			details = polled
+		case <-recheck:
+			// A stability window completes; the last status is evaluated again
+		}
 
-			conditionMet := evaluateConditionExpression(ctx, details, conditionExpr, log)
-
-			// Call callback on each poll
-			if callback != nil {
-				if err := callback(details, conditionMet); err != nil {
-					log.Warn(ctx, "Callback error (results may not be written)", logger.Fields{"error": err.Error()})
-				}
-			}
+		log.Debug(ctx, "Evaluating ManifestWork status", logger.Fields{
+			"name":       workName,
+			"conditions": len(details.Conditions),
+			"version":    details.Version,
+		})
 
-			log.Debug(ctx, "Polled ManifestWork status", logger.Fields{
-				"name":       workName,
-				"conditions": len(details.Conditions),
+		met, err := evaluate(details)
+		if err != nil {
+			return err
+		}
+		if met {
+			log.Info(ctx, "Condition met", logger.Fields{
+				"condition": conditionExpr,
+				"name":      workName,
 			})
-
-			if conditionMet {
-				log.Info(ctx, "Condition met", logger.Fields{
-					"condition": conditionExpr,
-					"name":      workName,
-				})
-				return nil
-			}
+			return nil
 		}
 	}
 }
 
-// WaitForDeletion polls for ManifestWork deletion using HTTP API
+// WaitForDeletion waits for ManifestWork deletion
+// Deletion is detected from gRPC status updates when available, with HTTP polling as a fallback/resync
 func (c *Client) WaitForDeletion(
 	ctx context.Context,
 	consumer, workName string,
@@ -1061,19 +1555,40 @@ func (c *Client) WaitForDeletion(
 		pollInterval = DefaultPollInterval
 	}
 
+	subCtx, cancelSub := context.WithCancel(ctx)
+	defer cancelSub()
+	events := c.SubscribeWorkStatus(subCtx, consumer, workName, log)
+
 	log.Info(ctx, "Polling for deletion", logger.Fields{
 		"name":          workName,
 		"consumer":      consumer,
 		"poll_interval": pollInterval.String(),
+		"events":        events != nil,
 	})
 
 	ticker := time.NewTicker(pollInterval)
 	defer ticker.Stop()
+	eventDriven := false
 
 	for {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
+		case evt, ok := <-events:
+			if !ok {
+				events = nil
+				eventDriven = false
+				ticker.Reset(pollInterval)
+				continue
+			}
+			eventDriven = switchToResync(ctx, eventDriven, ticker, log)
+			if evt.Deleted {
+				log.Info(ctx, "ManifestWork deleted", logger.Fields{
+					"name":     workName,
+					"consumer": consumer,
+				})
+				return nil
+			}
 		case <-ticker.C:
 			// Use HTTP API to check if ManifestWork still exists
 			_, err := c.GetManifestWorkByNameHTTP(ctx, consumer, workName)
@@ -1086,6 +1601,9 @@ func (c *Client) WaitForDeletion(
 					})
 					return nil
 				}
+				if !IsRetryableError(err) {
+					return fmt.Errorf("failed to poll for deletion: %w", err)
+				}
 				log.Warn(ctx, "Error polling for deletion", logger.Fields{
 					"error": err.Error(),
 				})
@@ -1094,191 +1612,39 @@ func (c *Client) WaitForDeletion(
 	}
 }
 
-// evaluateConditionExpression evaluates a condition expression with AND/OR logic
-// Supports:
-//   - ManifestWork conditions: "Available", "Applied"
-//   - StatusFeedback conditions: "Job:Complete", "Job:succeeded>=1"
-//   - Logical operators: "AND", "OR", "&&", "||"
-//   - Parentheses for grouping: "(A AND B) OR C"
-func evaluateConditionExpression(
-	ctx context.Context,
-	details *ManifestWorkDetails,
-	expr string,
-	log *logger.Logger,
-) bool {
-	expr = strings.TrimSpace(expr)
-	if expr == "" {
-		return false
-	}
-
-	// Handle parentheses - find matching pairs
-	if strings.HasPrefix(expr, "(") {
-		depth := 0
-		for i, ch := range expr {
-			if ch == '(' {
-				depth++
-			} else if ch == ')' {
-				depth--
-				if depth == 0 {
-					// Found matching closing paren
-					inner := expr[1:i]
-					rest := strings.TrimSpace(expr[i+1:])
-					innerResult := evaluateConditionExpression(ctx, details, inner, log)
-
-					if rest == "" {
-						return innerResult
-					}
-
-					// Check for operator after parentheses
-					if strings.HasPrefix(rest, "AND") || strings.HasPrefix(rest, "&&") {
-						if strings.HasPrefix(rest, "&&") {
-							rest = strings.TrimPrefix(rest, "&&")
-						} else {
-							rest = strings.TrimPrefix(rest, "AND")
-						}
-						return innerResult && evaluateConditionExpression(ctx, details, strings.TrimSpace(rest), log)
-					}
-					if strings.HasPrefix(rest, "OR") || strings.HasPrefix(rest, "||") {
-						if strings.HasPrefix(rest, "||") {
-							rest = strings.TrimPrefix(rest, "||")
-						} else {
-							rest = strings.TrimPrefix(rest, "OR")
-						}
-						return innerResult || evaluateConditionExpression(ctx, details, strings.TrimSpace(rest), log)
-					}
-					break
-				}
-			}
-		}
-	}
-
-	// Check for AND (higher precedence, evaluated first to split)
-	andParts := splitByOperator(expr, "AND", "&&")
-	if len(andParts) > 1 {
-		for _, part := range andParts {
-			if !evaluateConditionExpression(ctx, details, strings.TrimSpace(part), log) {
-				return false
-			}
-		}
-		return true
-	}
-
-	// Check for OR (lower precedence)
-	orParts := splitByOperator(expr, "OR", "||")
-	if len(orParts) > 1 {
-		for _, part := range orParts {
-			if evaluateConditionExpression(ctx, details, strings.TrimSpace(part), log) {
-				return true
-			}
-		}
-		return false
-	}
-
-	// Single condition - evaluate it
-	return evaluateSingleCondition(ctx, details, expr, log)
-}
-
-// splitByOperator splits expression by operator, respecting parentheses
-func splitByOperator(expr, op1, op2 string) []string {
-	var parts []string
-	var current strings.Builder
-	depth := 0
-
-	words := strings.Fields(expr)
-	for _, word := range words {
-		if word == "(" || strings.HasPrefix(word, "(") {
-			depth += strings.Count(word, "(") - strings.Count(word, ")")
-		} else if word == ")" || strings.HasSuffix(word, ")") {
-			depth += strings.Count(word, "(") - strings.Count(word, ")")
-		}
-
-		if depth == 0 && (word == op1 || word == op2) {
-			if current.Len() > 0 {
-				parts = append(parts, current.String())
-				current.Reset()
-			}
-			continue
-		}
-
-		if current.Len() > 0 {
-			current.WriteString(" ")
-		}
-		current.WriteString(word)
-
-		// Handle inline operators like "A&&B" or "A||B"
-		if depth == 0 {
-			if strings.Contains(word, op2) && op2 != "" {
-				// Split the current content by the inline operator
-				content := current.String()
-				subParts := strings.Split(content, op2)
-				// Add the first part
-				if subParts[0] != "" {
-					parts = append(parts, subParts[0])
-				}
-				// Add remaining parts separated by the operator
-				for _, part := range subParts[1:] {
-					if part != "" {
-						parts = append(parts, part)
-					}
-				}
-				// Reset current since we've processed the entire content
-				current.Reset()
-				continue
-			}
-		}
-	}
-
-	if current.Len() > 0 {
-		parts = append(parts, current.String())
-	}
-
-	return parts
-}
-
-// evaluateSingleCondition evaluates a single condition (no logical operators)
-func evaluateSingleCondition(
-	ctx context.Context,
-	details *ManifestWorkDetails,
-	condition string,
-	log *logger.Logger,
-) bool {
-	condition = strings.TrimSpace(condition)
-
-	log.Debug(ctx, "Evaluating single condition", logger.Fields{
-		"condition": condition,
-		"trimmed":   condition == "",
-	})
-
-	// Empty conditions are invalid
-	if condition == "" {
-		log.Debug(ctx, "Empty condition detected, returning false")
-		return false
-	}
-
-	// Check if it's a statusFeedback condition (contains ":")
-	if strings.Contains(condition, ":") {
-		return evaluateStatusFeedbackCondition(ctx, details, condition, log)
+// switchToResync slows HTTP polling down to the resync interval once the first
+// status update has been received over gRPC, and returns the new event-driven state
+func switchToResync(ctx context.Context, eventDriven bool, ticker *time.Ticker, log *logger.Logger) bool {
+	if !eventDriven {
+		ticker.Reset(DefaultResyncInterval)
+		log.Debug(ctx, "Receiving status updates over gRPC, HTTP polling reduced to resync", logger.Fields{
+			"resync_interval": DefaultResyncInterval.String(),
+		})
 	}
-
-	// Otherwise, it's a ManifestWork-level condition
-	return checkDetailsCondition(ctx, details, condition, log)
+	return true
 }
 
-// checkDetailsCondition checks ManifestWork-level conditions from details
-// For conditions other than "Applied", it also verifies that the condition's
-// lastTransitionTime is >= the "Applied" condition's lastTransitionTime to ensure
-// we're seeing fresh status and not stale data from a previous apply
+// checkDetailsCondition checks that a ManifestWork-level condition from details has status, usually True
+// It also verifies that we're seeing fresh status and not stale data from a previous apply: when the
+// condition reports an observedGeneration it must be the current generation of the ManifestWork.
+// Otherwise, for conditions other than "Applied", the condition's lastTransitionTime must be >= the
+// "Applied" condition's lastTransitionTime, which cannot tell apart applies within the same second.
+// The check is recorded in trace when it is not nil.
 func checkDetailsCondition(
 	ctx context.Context,
 	details *ManifestWorkDetails,
-	condType string,
+	condType, status string,
+	trace *ConditionTrace,
 	log *logger.Logger,
 ) bool {
-	// Find the target condition and Applied condition
-	var targetCond, appliedCond *ConditionSummary
+	// Find the target condition and Applied condition, and the target condition in any status
+	var targetCond, appliedCond, reportedCond *ConditionSummary
 	for i := range details.Conditions {
 		cond := &details.Conditions[i]
-		if strings.EqualFold(cond.Type, condType) && cond.Status == statusTrue {
+		if strings.EqualFold(cond.Type, condType) && reportedCond == nil {
+			reportedCond = cond
+		}
+		if strings.EqualFold(cond.Type, condType) && strings.EqualFold(cond.Status, status) {
 			targetCond = cond
 		}
 		if strings.EqualFold(cond.Type, "Applied") && cond.Status == statusTrue {
@@ -1288,9 +1654,18 @@ func checkDetailsCondition(
 
 	// Target condition not met
 	if targetCond == nil {
-		log.Debug(ctx, "ManifestWork condition not found or not True", logger.Fields{
+		log.Debug(ctx, "ManifestWork condition not found or not "+status, logger.Fields{
 			"condition": condType,
 		})
+		check := ConditionCheck{Reason: fmt.Sprintf("%s is not reported", condType)}
+		if reportedCond != nil {
+			check.Actual = reportedCond.Status
+			check.Reason = fmt.Sprintf("%s is %s", condType, reportedCond.Status)
+			if reportedCond.Reason != "" {
+				check.Reason += fmt.Sprintf(" (%s)", reportedCond.Reason)
+			}
+		}
+		trace.addCheck(check)
 		return false
 	}
 
@@ -1299,250 +1674,341 @@ func checkDetailsCondition(
 		"status":             targetCond.Status,
 		"lastTransitionTime": targetCond.LastTransitionTime,
 	})
+	check := ConditionCheck{Actual: targetCond.Status, Result: true,
+		Reason: fmt.Sprintf("%s is %s", condType, targetCond.Status)}
+
+	// The condition is fresh if the agent set it for the current generation of the ManifestWork
+	if generation := details.generation(); generation > 0 && targetCond.ObservedGeneration > 0 {
+		fresh := targetCond.ObservedGeneration == generation
+		check.Fresh = &fresh
+		log.Debug(ctx, "Comparing condition generations", logger.Fields{
+			"condition":          condType,
+			"observedGeneration": targetCond.ObservedGeneration,
+			"generation":         generation,
+			"isFresh":            fresh,
+		})
+		if !fresh {
+			check.Result = false
+			check.Reason = fmt.Sprintf("%s is %s but stale: it was observed at generation %d, the ManifestWork is at %d",
+				condType, targetCond.Status, targetCond.ObservedGeneration, generation)
+		}
+		trace.addCheck(check)
+		return fresh
+	}
 
 	// If checking "Applied" itself, or no Applied condition exists, just return true
 	if strings.EqualFold(condType, "Applied") || appliedCond == nil {
+		trace.addCheck(check)
 		return true
 	}
 
-	// For other conditions, verify the timestamp is fresh (>= Applied time)
+	// Without an observed generation, verify the timestamp is fresh (>= Applied time)
 	if targetCond.LastTransitionTime != "" && appliedCond.LastTransitionTime != "" {
 		targetTime, err1 := time.Parse(time.RFC3339, targetCond.LastTransitionTime)
 		appliedTime, err2 := time.Parse(time.RFC3339, appliedCond.LastTransitionTime)
 		if err1 == nil && err2 == nil {
+			fresh := !targetTime.Before(appliedTime)
+			check.Fresh = &fresh
 			log.Debug(ctx, "Comparing condition timestamps", logger.Fields{
 				"condition":     condType,
 				"conditionTime": targetCond.LastTransitionTime,
 				"appliedTime":   appliedCond.LastTransitionTime,
-				"isFresh":       !targetTime.Before(appliedTime),
+				"isFresh":       fresh,
 			})
 			// Condition must have transitioned at or after the Applied time
-			if targetTime.Before(appliedTime) {
+			if !fresh {
 				log.Debug(ctx, "Condition is stale (before Applied time)", logger.Fields{
 					"condition":     condType,
 					"conditionTime": targetCond.LastTransitionTime,
 					"appliedTime":   appliedCond.LastTransitionTime,
 				})
+				check.Result = false
+				check.Reason = fmt.Sprintf("%s is %s but stale: it transitioned at %s, before Applied at %s",
+					condType, targetCond.Status, targetCond.LastTransitionTime, appliedCond.LastTransitionTime)
+				trace.addCheck(check)
 				return false
 			}
 		}
 	}
 
+	trace.addCheck(check)
 	return true
 }
 
-// evaluateStatusFeedbackCondition evaluates a statusFeedback condition
-// Format: "Kind:condition" or "Kind/name:condition" or "Kind/namespace/name:condition"
-// Examples: "Job:Complete", "Job/test-job-1:Complete", "Job/default/test-job:succeeded>=1"
+// evaluateStatusFeedbackCondition evaluates a resource condition or statusFeedback comparison
+// against the resources matched by the selector of node, combined by its quantifier: any (the
+// default for a bare selector) needs one matching resource to satisfy the check, all needs every
+// one of them and count compares the number that do. A selector matching no resource is never satisfied.
+// Examples: "Job:Complete", "all(Deployment/prod-*/*):Available", "count(Job{app=batch}:Complete)>=3"
+// Each matching resource that is checked is recorded in trace when it is not nil.
 func evaluateStatusFeedbackCondition(
 	ctx context.Context,
 	details *ManifestWorkDetails,
-	condition string,
+	node *resourceConditionNode,
+	trace *ConditionTrace,
 	log *logger.Logger,
 ) bool {
-	parts := strings.SplitN(condition, ":", 2)
-	if len(parts) != 2 {
-		return false
-	}
-
-	resourceSelector := strings.TrimSpace(parts[0])
-	check := strings.TrimSpace(parts[1])
-
-	// Parse resource selector: Kind, Kind/name, or Kind/namespace/name
-	selectorParts := strings.Split(resourceSelector, "/")
-	kind := selectorParts[0]
-	var name, namespace string
-	if len(selectorParts) == 2 {
-		// Kind/name format
-		name = selectorParts[1]
-	} else if len(selectorParts) >= 3 {
-		// Kind/namespace/name format
-		namespace = selectorParts[1]
-		name = selectorParts[2]
-	}
+	selector := node.selector
 
 	log.Debug(ctx, "Evaluating resource condition", logger.Fields{
-		"kind":      kind,
-		"name":      name,
-		"namespace": namespace,
-		"check":     check,
+		"selector":   selector.String(),
+		"quantifier": node.quantifier,
+		"check":      node.check,
 	})
 
-	// Get ManifestWork-level Applied timestamp for freshness check
-	var manifestAppliedTime time.Time
-	var manifestAppliedTimeStr string
+	// Get ManifestWork generation and Applied timestamp for freshness check
+	applied := appliedState{generation: details.generation()}
 	for _, cond := range details.Conditions {
 		if strings.EqualFold(cond.Type, statusApplied) && cond.Status == statusTrue && cond.LastTransitionTime != "" {
-			manifestAppliedTimeStr = cond.LastTransitionTime
+			applied.text = cond.LastTransitionTime
 			if t, err := time.Parse(time.RFC3339, cond.LastTransitionTime); err == nil {
-				manifestAppliedTime = t
+				applied.time = t
 			}
 			break
 		}
 	}
 
-	// Find matching resource by kind (and optionally name/namespace)
+	// Check every resource matched by kind (and optionally name, namespace and labels)
+	matched, satisfied := 0, 0
 	for _, rs := range details.ResourceStatus {
-		if !strings.EqualFold(rs.Kind, kind) {
+		if !selector.matches(rs, details) {
 			continue
 		}
-		// If name specified, must match
-		if name != "" && !strings.EqualFold(rs.Name, name) {
-			continue
+		matched++
+		result := checkResource(ctx, rs, applied, node, trace, log)
+		if result {
+			satisfied++
 		}
-		// If namespace specified, must match
-		if namespace != "" && !strings.EqualFold(rs.Namespace, namespace) {
-			continue
+		// Without a trace, any stops at the first resource that satisfies the check and all at the first that does not
+		if trace == nil && node.quantifier != quantifierCount && result != (node.quantifier == quantifierAll) {
+			return result
 		}
+	}
 
-		log.Debug(ctx, "Found matching resource", logger.Fields{
-			"kind":      rs.Kind,
-			"name":      rs.Name,
-			"namespace": rs.Namespace,
+	if matched == 0 {
+		trace.explain("no resource matches %s", selector)
+		log.Debug(ctx, "No matching resource found for condition", logger.Fields{
+			"selector":  selector.String(),
+			"condition": node.check,
 		})
+		return false
+	}
 
-		// Verify resource has fresh Applied status (>= ManifestWork Applied time)
-		// Only skip if resource has Applied timestamp AND it's before ManifestWork Applied time
-		if !manifestAppliedTime.IsZero() {
-			resourceFresh := true // Default to fresh if we can't determine staleness
-			var resourceAppliedTimeStr string
-			var foundAppliedCondition bool
-
-			for _, cond := range rs.Conditions {
-				if strings.EqualFold(cond.Type, "Applied") && cond.Status == statusTrue {
-					foundAppliedCondition = true
-					resourceAppliedTimeStr = cond.LastTransitionTime
-					if cond.LastTransitionTime != "" {
-						if t, err := time.Parse(time.RFC3339, cond.LastTransitionTime); err == nil {
-							resourceFresh = !t.Before(manifestAppliedTime)
-						}
-					} else {
-						// Resource has Applied condition but no timestamp - consider fresh
-						resourceFresh = true
-					}
-					break
-				}
-			}
+	switch node.quantifier {
+	case quantifierAll:
+		trace.explain("%d of %d matching resources satisfy the check", satisfied, matched)
+		return satisfied == matched
+	case quantifierCount:
+		trace.explain("%d of %d matching resources satisfy the check", satisfied, matched)
+		return evaluateComparison(satisfied, node.countOperator, strconv.Itoa(node.count))
+	default:
+		return satisfied > 0
+	}
+}
 
-			log.Debug(ctx, "Resource freshness check", logger.Fields{
-				"resource":              fmt.Sprintf("%s/%s", rs.Kind, rs.Name),
-				"foundAppliedCondition": foundAppliedCondition,
-				"resourceAppliedTime":   resourceAppliedTimeStr,
-				"manifestAppliedTime":   manifestAppliedTimeStr,
-				"isFresh":               resourceFresh,
-			})
+// appliedState identifies the last apply of the ManifestWork: its generation, and the time it was
+// applied as parsed and as reported
+type appliedState struct {
+	generation int64
+	time       time.Time
+	text       string
+}
 
-			if !resourceFresh {
-				log.Debug(ctx, "Skipping stale resource status", logger.Fields{
-					"resource": fmt.Sprintf("%s/%s", rs.Kind, rs.Name),
-				})
-				continue // Skip stale resource status
-			}
-		}
+// checkResource evaluates a resource condition or statusFeedback comparison against one resource
+// A resource whose status is stale never satisfies the check: its Applied condition observed an older
+// generation than the ManifestWork's or, without an observed generation, transitioned before the
+// ManifestWork Applied time.
+func checkResource(
+	ctx context.Context,
+	rs ResourceStatusInfo,
+	applied appliedState,
+	node *resourceConditionNode,
+	trace *ConditionTrace,
+	log *logger.Logger,
+) bool {
+	resource := resourceSelector{Kind: rs.Kind, Namespace: rs.Namespace, Name: rs.Name}.String()
 
-		// Check if it's a comparison (=, >=, <=, >, <)
-		if strings.Contains(check, ">=") {
-			return evaluateComparison(rs.StatusFeedback, check, ">=")
-		}
-		if strings.Contains(check, "<=") {
-			return evaluateComparison(rs.StatusFeedback, check, "<=")
-		}
-		if strings.Contains(check, ">") && !strings.Contains(check, ">=") {
-			return evaluateComparison(rs.StatusFeedback, check, ">")
-		}
-		if strings.Contains(check, "<") && !strings.Contains(check, "<=") {
-			return evaluateComparison(rs.StatusFeedback, check, "<")
-		}
-		if strings.Contains(check, "=") {
-			return evaluateComparison(rs.StatusFeedback, check, "=")
-		}
+	log.Debug(ctx, "Found matching resource", logger.Fields{
+		"kind":      rs.Kind,
+		"name":      rs.Name,
+		"namespace": rs.Namespace,
+	})
 
-		// Otherwise, check if it's a condition name in statusFeedback.conditions or resource conditions
-		// First check resource-level conditions (Applied, Available, StatusFeedbackSynced)
-		for _, cond := range rs.Conditions {
-			if strings.EqualFold(cond.Type, check) && cond.Status == statusTrue {
-				log.Debug(ctx, "Resource condition matched", logger.Fields{
-					"resource":  fmt.Sprintf("%s/%s", rs.Kind, rs.Name),
-					"condition": check,
-					"status":    cond.Status,
-				})
-				return true
-			}
+	var resourceApplied *ConditionSummary
+	for i := range rs.Conditions {
+		if strings.EqualFold(rs.Conditions[i].Type, statusApplied) && rs.Conditions[i].Status == statusTrue {
+			resourceApplied = &rs.Conditions[i]
+			break
 		}
+	}
 
-		// Then check statusFeedback for conditions
-		if rs.StatusFeedback != nil {
-			// Check if statusFeedback has a "conditions" array
-			if feedbackConds, ok := rs.StatusFeedback["conditions"].([]interface{}); ok {
-				for _, fc := range feedbackConds {
-					if fcMap, ok := fc.(map[string]interface{}); ok {
-						if t, ok := fcMap["type"].(string); ok && strings.EqualFold(t, check) {
-							if s, ok := fcMap["status"].(string); ok && s == "True" {
-								log.Debug(ctx, "StatusFeedback condition matched", logger.Fields{
-									"resource":  fmt.Sprintf("%s/%s", rs.Kind, rs.Name),
-									"condition": check,
-									"status":    s,
-								})
-								return true
-							}
-						}
-					}
-				}
-			}
-			// Check if statusFeedback.status has conditions
-			if status, ok := rs.StatusFeedback["status"].(map[string]interface{}); ok {
-				if statusConds, ok := status["conditions"].([]interface{}); ok {
-					for _, sc := range statusConds {
-						if scMap, ok := sc.(map[string]interface{}); ok {
-							if t, ok := scMap["type"].(string); ok && strings.EqualFold(t, check) {
-								if s, ok := scMap["status"].(string); ok && s == "True" {
-									log.Debug(ctx, "StatusFeedback.status condition matched", logger.Fields{
-										"resource":  fmt.Sprintf("%s/%s", rs.Kind, rs.Name),
-										"condition": check,
-										"status":    s,
-									})
-									return true
-								}
-							}
-						}
-					}
-				}
+	// Verify resource has fresh Applied status: for the current generation when the agent reports the
+	// generation it observed, otherwise no older than the ManifestWork Applied time
+	var fresh *bool
+	stale := ""
+	switch {
+	case applied.generation > 0 && resourceApplied != nil && resourceApplied.ObservedGeneration > 0:
+		resourceFresh := resourceApplied.ObservedGeneration == applied.generation
+		fresh = &resourceFresh
+		log.Debug(ctx, "Resource freshness check", logger.Fields{
+			"resource":           fmt.Sprintf("%s/%s", rs.Kind, rs.Name),
+			"observedGeneration": resourceApplied.ObservedGeneration,
+			"generation":         applied.generation,
+			"isFresh":            resourceFresh,
+		})
+		if !resourceFresh {
+			stale = fmt.Sprintf("status is stale: resource observed generation %d, the ManifestWork is at %d",
+				resourceApplied.ObservedGeneration, applied.generation)
+		}
+
+	case !applied.time.IsZero():
+		// Only stale if resource has Applied timestamp AND it's before ManifestWork Applied time
+		resourceFresh := true
+		var resourceAppliedTimeStr string
+		if resourceApplied != nil && resourceApplied.LastTransitionTime != "" {
+			resourceAppliedTimeStr = resourceApplied.LastTransitionTime
+			if t, err := time.Parse(time.RFC3339, resourceAppliedTimeStr); err == nil {
+				resourceFresh = !t.Before(applied.time)
 			}
 		}
+		fresh = &resourceFresh
+		log.Debug(ctx, "Resource freshness check", logger.Fields{
+			"resource":              fmt.Sprintf("%s/%s", rs.Kind, rs.Name),
+			"foundAppliedCondition": resourceApplied != nil,
+			"resourceAppliedTime":   resourceAppliedTimeStr,
+			"manifestAppliedTime":   applied.text,
+			"isFresh":               resourceFresh,
+		})
+		if !resourceFresh {
+			stale = fmt.Sprintf("status is stale: resource applied at %s, before the ManifestWork was applied at %s",
+				resourceAppliedTimeStr, applied.text)
+		}
+	}
 
-		log.Debug(ctx, "Condition not found in resource", logger.Fields{
+	if stale != "" {
+		log.Debug(ctx, "Skipping stale resource status", logger.Fields{
+			"resource": fmt.Sprintf("%s/%s", rs.Kind, rs.Name),
+		})
+		trace.addCheck(ConditionCheck{Resource: resource, Fresh: fresh, Reason: stale})
+		return false // Skip stale resource status
+	}
+
+	// Comparisons (=, !=, =~, >=, <=, >, <) are evaluated against the statusFeedback values of a path
+	check := node.check
+	if node.path != nil {
+		actual, _ := node.path.lookup(rs.StatusFeedback)
+		result := evaluateComparison(actual, node.operator, node.value)
+		comparison := ConditionCheck{Resource: resource, Fresh: fresh, Result: result,
+			Reason: fmt.Sprintf("%s is missing from the status feedback", check)}
+		if actual != nil {
+			comparison.Actual = fmt.Sprintf("%v", actual)
+			comparison.Reason = fmt.Sprintf("%s is %v", check, actual)
+		}
+		trace.addCheck(comparison)
+		return result
+	}
+
+	// Otherwise, check if it's a condition name in the resource conditions or statusFeedback
+	status, found := resourceConditionStatus(rs, check)
+	condition := ConditionCheck{Resource: resource, Fresh: fresh, Actual: status, Result: status == statusTrue,
+		Reason: fmt.Sprintf("%s is not reported", check)}
+	if found {
+		condition.Reason = fmt.Sprintf("%s is %s", check, status)
+	}
+	trace.addCheck(condition)
+	if condition.Result {
+		log.Debug(ctx, "Resource condition matched", logger.Fields{
 			"resource":  fmt.Sprintf("%s/%s", rs.Kind, rs.Name),
 			"condition": check,
+			"status":    status,
 		})
+		return true
 	}
 
-	log.Debug(ctx, "No matching resource found for condition", logger.Fields{
-		"kind":      kind,
+	log.Debug(ctx, "Condition not found in resource", logger.Fields{
+		"resource":  fmt.Sprintf("%s/%s", rs.Kind, rs.Name),
 		"condition": check,
 	})
 	return false
 }
 
-// evaluateComparison evaluates a comparison like "succeeded>=1" or "status.phase=Active"
-func evaluateComparison(feedback map[string]interface{}, check, operator string) bool {
-	parts := strings.SplitN(check, operator, 2)
-	if len(parts) != 2 {
-		return false
+// resourceConditionStatus returns the status of a condition of a resource
+// The condition is looked up in the resource conditions (Applied, Available, StatusFeedbackSynced),
+// then in statusFeedback.conditions and statusFeedback.status.conditions. A True status in any of
+// them wins, otherwise the first status found is returned. found is false if none reports it.
+func resourceConditionStatus(rs ResourceStatusInfo, condType string) (status string, found bool) {
+	// consider records the status of a condition and reports whether it is the True condType
+	consider := func(t, s string) bool {
+		if !strings.EqualFold(t, condType) {
+			return false
+		}
+		if !found {
+			status, found = s, true
+		}
+		return s == statusTrue
 	}
 
-	fieldPath := strings.TrimSpace(parts[0])
-	expectedValue := strings.TrimSpace(parts[1])
+	for _, cond := range rs.Conditions {
+		if consider(cond.Type, cond.Status) {
+			return statusTrue, true
+		}
+	}
+	for _, cond := range feedbackConditions(rs.StatusFeedback) {
+		t, _ := cond["type"].(string)
+		s, _ := cond["status"].(string)
+		if consider(t, s) {
+			return statusTrue, true
+		}
+	}
+	return status, found
+}
+
+// feedbackConditions returns the conditions in statusFeedback.conditions and statusFeedback.status.conditions
+func feedbackConditions(feedback map[string]interface{}) []map[string]interface{} {
+	lists := []interface{}{feedback["conditions"]}
+	if status, ok := feedback["status"].(map[string]interface{}); ok {
+		lists = append(lists, status["conditions"])
+	}
+
+	var conditions []map[string]interface{}
+	for _, list := range lists {
+		items, _ := list.([]interface{})
+		for _, item := range items {
+			if cond, ok := item.(map[string]interface{}); ok {
+				conditions = append(conditions, cond)
+			}
+		}
+	}
+	return conditions
+}
 
-	// Get the actual value from feedback
-	actualValue := getValueFromPath(feedback, fieldPath)
+// evaluateComparison evaluates a comparison like "succeeded>=1" or "status.phase=Active"
+// against the statusFeedback value of the field; a missing value never matches.
+// Values selected by a wildcard or filter match when any of them does, or for != when none is equal.
+func evaluateComparison(actualValue interface{}, operator, expectedValue string) bool {
 	if actualValue == nil {
 		return false
 	}
+	if matches, ok := actualValue.(pathMatches); ok {
+		if operator == "!=" {
+			return !evaluateComparison(matches, "=", expectedValue)
+		}
+		for _, match := range matches {
+			if evaluateComparison(match, operator, expectedValue) {
+				return true
+			}
+		}
+		return false
+	}
 
 	// Compare based on operator
 	switch operator {
 	case "=":
 		return compareEqual(actualValue, expectedValue)
+	case "!=":
+		return !compareEqual(actualValue, expectedValue)
+	case "=~":
+		return compareRegexp(actualValue, expectedValue)
 	case ">=":
 		return compareNumeric(actualValue, expectedValue, ">=")
 	case "<=":
@@ -1556,28 +2022,6 @@ func evaluateComparison(feedback map[string]interface{}, check, operator string)
 	return false
 }
 
-// getValueFromPath gets a value from nested map using dot notation
-// e.g., "status.succeeded" from {"status": {"succeeded": 1}}
-func getValueFromPath(data map[string]interface{}, path string) interface{} {
-	if data == nil {
-		return nil
-	}
-
-	parts := strings.Split(path, ".")
-	var current interface{} = data
-
-	for _, part := range parts {
-		switch v := current.(type) {
-		case map[string]interface{}:
-			current = v[part]
-		default:
-			return nil
-		}
-	}
-
-	return current
-}
-
 // compareEqual compares for equality
 func compareEqual(actual interface{}, expected string) bool {
 	switch v := actual.(type) {
@@ -1598,6 +2042,12 @@ func compareEqual(actual interface{}, expected string) bool {
 	}
 }
 
+// compareRegexp matches the value, formatted as a string, against an unanchored regular expression
+func compareRegexp(actual interface{}, pattern string) bool {
+	matched, err := regexp.MatchString(pattern, fmt.Sprintf("%v", actual))
+	return err == nil && matched
+}
+
 // compareNumeric compares numeric values
 func compareNumeric(actual interface{}, expected string, operator string) bool {
 	var actualNum float64
@@ -1711,6 +2161,34 @@ func getToken(config ClientConfig) string {
 	return os.Getenv("MAESTRO_GRPC_TOKEN")
 }
 
+// getHTTPToken retrieves the bearer token for the HTTP API
+// Priority: direct HTTP token > HTTP token file > gRPC token
+func getHTTPToken(config ClientConfig) (string, error) {
+	if config.HTTPToken != "" {
+		return config.HTTPToken, nil
+	}
+
+	if config.HTTPTokenFile != "" {
+		tokenBytes, err := os.ReadFile(config.HTTPTokenFile)
+		if err != nil {
+			return "", fmt.Errorf("failed to read HTTP token file: %w", err)
+		}
+		return strings.TrimSpace(string(tokenBytes)), nil
+	}
+
+	return getToken(config), nil
+}
+
+// firstNonEmpty returns the first non-empty value
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
 // validateSearchQuery validates search query parameters to prevent SQL injection
 // This is a defensive measure - Maestro should have its own validation, but this provides extra safety
 func validateSearchQuery(value string) error {
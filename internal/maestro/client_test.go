@@ -3,10 +3,23 @@ package maestro
 import (
 	"context"
 	"crypto/tls"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strconv"
 	"testing"
+	"time"
 
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"k8s.io/apimachinery/pkg/runtime"
+	workv1 "open-cluster-management.io/api/work/v1"
+
+	clierrors "github.com/openshift-hyperfleet/maestro-cli/pkg/errors"
 	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
 )
 
@@ -146,6 +159,128 @@ func TestGetToken(t *testing.T) {
 	}
 }
 
+func TestGetHTTPToken(t *testing.T) {
+	dir := t.TempDir()
+	tokenFile := filepath.Join(dir, "http-token.txt")
+	if err := os.WriteFile(tokenFile, []byte("http-file-token\n"), 0600); err != nil {
+		t.Fatalf("failed to create token file: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		config      ClientConfig
+		expected    string
+		expectError bool
+	}{
+		{
+			name:     "HTTP token has highest priority",
+			config:   ClientConfig{HTTPToken: "http-token", HTTPTokenFile: tokenFile, GRPCClientToken: "grpc-token"},
+			expected: "http-token",
+		},
+		{
+			name:     "HTTP token file when no HTTP token",
+			config:   ClientConfig{HTTPTokenFile: tokenFile, GRPCClientToken: "grpc-token"},
+			expected: "http-file-token",
+		},
+		{
+			name:     "defaults to gRPC token",
+			config:   ClientConfig{GRPCClientToken: "grpc-token"},
+			expected: "grpc-token",
+		},
+		{
+			name:        "missing HTTP token file",
+			config:      ClientConfig{HTTPTokenFile: filepath.Join(dir, "missing.txt")},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := getHTTPToken(tt.config)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("getHTTPToken() = %q, expected %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestHTTPClientSendsBearerToken(t *testing.T) {
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"kind": "ConsumerList", "page": 1, "size": 0, "total": 0, "items": []interface{}{},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewHTTPClient(ClientConfig{HTTPEndpoint: server.URL, GRPCClientToken: "grpc-token"})
+	if err != nil {
+		t.Fatalf("NewHTTPClient() unexpected error: %v", err)
+	}
+	if _, err := client.ListConsumers(context.Background()); err != nil {
+		t.Fatalf("ListConsumers() unexpected error: %v", err)
+	}
+	if authorization != "Bearer grpc-token" {
+		t.Errorf("Authorization header = %q, expected %q", authorization, "Bearer grpc-token")
+	}
+}
+
+func TestCreateHTTPTLSConfig(t *testing.T) {
+	t.Run("client key required with cert", func(t *testing.T) {
+		_, err := createHTTPTLSConfig(ClientConfig{HTTPClientCertFile: "/tmp/cert.pem"})
+		if err == nil {
+			t.Error("expected error but got none")
+		}
+	})
+
+	t.Run("defaults to gRPC server CA", func(t *testing.T) {
+		_, err := createHTTPTLSConfig(ClientConfig{GRPCServerCAFile: "/nonexistent/ca.pem"})
+		if err == nil {
+			t.Error("expected error reading the gRPC server CA, got none")
+		}
+	})
+}
+
+func TestHTTPClientVerifiesServerWithHTTPCA(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"kind": "ConsumerList", "page": 1, "size": 0, "total": 0, "items": []interface{}{},
+		})
+	}))
+	defer server.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caFile, caPEM, 0600); err != nil {
+		t.Fatalf("failed to write CA file: %v", err)
+	}
+
+	// The HTTP CA takes precedence over the (unreadable) gRPC server CA
+	client, err := NewHTTPClient(ClientConfig{
+		HTTPEndpoint:     server.URL,
+		HTTPCAFile:       caFile,
+		GRPCServerCAFile: "/nonexistent/grpc-ca.pem",
+	})
+	if err != nil {
+		t.Fatalf("NewHTTPClient() unexpected error: %v", err)
+	}
+	if _, err := client.ListConsumers(context.Background()); err != nil {
+		t.Errorf("ListConsumers() over TLS unexpected error: %v", err)
+	}
+}
+
 func TestValidateSearchQuery(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -273,11 +408,101 @@ func TestEvaluateConditionExpression(t *testing.T) {
 			expected: false,
 		},
 		{
-			name:     "empty expression",
-			expr:     "",
-			details:  &ManifestWorkDetails{},
+			name: "NOT expression",
+			expr: "Available AND NOT Degraded",
+			details: &ManifestWorkDetails{
+				Conditions: []ConditionSummary{
+					{Type: "Available", Status: "True"},
+					{Type: "Degraded", Status: "False"},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "AND binds tighter than OR",
+			expr: "Degraded OR Available AND Progressing",
+			details: &ManifestWorkDetails{
+				Conditions: []ConditionSummary{
+					{Type: "Available", Status: "True"},
+					{Type: "Progressing", Status: "False"},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "condition observed at the current generation is fresh despite an older timestamp",
+			expr: "Available",
+			details: &ManifestWorkDetails{
+				Version: 3,
+				Conditions: []ConditionSummary{
+					{Type: "Applied", Status: "True", LastTransitionTime: "2024-05-01T10:00:01Z", ObservedGeneration: 3},
+					{Type: "Available", Status: "True", LastTransitionTime: "2024-05-01T10:00:00Z", ObservedGeneration: 3},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "condition observed at an older generation is stale within the same second",
+			expr: "Available",
+			details: &ManifestWorkDetails{
+				Version: 3,
+				Conditions: []ConditionSummary{
+					{Type: "Applied", Status: "True", LastTransitionTime: "2024-05-01T10:00:00Z", ObservedGeneration: 3},
+					{Type: "Available", Status: "True", LastTransitionTime: "2024-05-01T10:00:00Z", ObservedGeneration: 2},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "Applied observed at an older generation is stale",
+			expr: "Applied",
+			details: &ManifestWorkDetails{
+				Version:    3,
+				Generation: 4,
+				Conditions: []ConditionSummary{
+					{Type: "Applied", Status: "True", ObservedGeneration: 3},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "resource observed at an older generation is stale",
+			expr: "Job:Complete",
+			details: &ManifestWorkDetails{
+				Version: 3,
+				Conditions: []ConditionSummary{
+					{Type: "Applied", Status: "True", LastTransitionTime: "2024-05-01T10:00:00Z", ObservedGeneration: 3},
+				},
+				ResourceStatus: []ResourceStatusInfo{{
+					Kind: "Job",
+					Name: "pi",
+					Conditions: []ConditionSummary{
+						{Type: "Applied", Status: "True", LastTransitionTime: "2024-05-01T10:00:00Z", ObservedGeneration: 2},
+						{Type: "Complete", Status: "True"},
+					},
+				}},
+			},
 			expected: false,
 		},
+		{
+			name: "resource observed at the current generation is fresh",
+			expr: "Job:Complete",
+			details: &ManifestWorkDetails{
+				Version: 3,
+				Conditions: []ConditionSummary{
+					{Type: "Applied", Status: "True", LastTransitionTime: "2024-05-01T10:00:01Z", ObservedGeneration: 3},
+				},
+				ResourceStatus: []ResourceStatusInfo{{
+					Kind: "Job",
+					Name: "pi",
+					Conditions: []ConditionSummary{
+						{Type: "Applied", Status: "True", LastTransitionTime: "2024-05-01T10:00:00Z", ObservedGeneration: 3},
+						{Type: "Complete", Status: "True"},
+					},
+				}},
+			},
+			expected: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -288,7 +513,11 @@ func TestEvaluateConditionExpression(t *testing.T) {
 				Format: "text",
 			})
 			ctx := context.Background()
-			result := evaluateConditionExpression(ctx, tt.details, tt.expr, log)
+			condition, err := ParseCondition(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseCondition(%q) unexpected error: %v", tt.expr, err)
+			}
+			result := condition.Evaluate(ctx, tt.details, log)
 			if result != tt.expected {
 				t.Errorf("evaluateConditionExpression(%q) = %v, expected %v", tt.expr, result, tt.expected)
 			}
@@ -296,93 +525,316 @@ func TestEvaluateConditionExpression(t *testing.T) {
 	}
 }
 
-func TestSplitByOperator(t *testing.T) {
+func TestDetailsFromBundleGenerations(t *testing.T) {
+	version := int32(3)
+	bundle := &openapi.ResourceBundle{
+		Version:  &version,
+		Metadata: map[string]interface{}{"name": "test-mw", "generation": float64(4)},
+		Status: map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Applied", "status": "True", "observedGeneration": float64(4)},
+			},
+			"resourceStatus": []interface{}{map[string]interface{}{
+				"resourceMeta": map[string]interface{}{"kind": "Job", "name": "pi"},
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Applied", "status": "True", "observedGeneration": float64(3)},
+				},
+			}},
+		},
+	}
+
+	details := detailsFromBundle("agent1", "test-mw", bundle)
+	if details.Generation != 4 || details.generation() != 4 {
+		t.Errorf("generation = %d (%d), expected 4 from metadata", details.Generation, details.generation())
+	}
+	if len(details.Conditions) != 1 || details.Conditions[0].ObservedGeneration != 4 {
+		t.Errorf("unexpected conditions %+v", details.Conditions)
+	}
+	if len(details.ResourceStatus) != 1 || len(details.ResourceStatus[0].Conditions) != 1 ||
+		details.ResourceStatus[0].Conditions[0].ObservedGeneration != 3 {
+		t.Errorf("unexpected resource status %+v", details.ResourceStatus)
+	}
+
+	// Without metadata.generation the bundle version is the generation
+	delete(bundle.Metadata, "generation")
+	if got := detailsFromBundle("agent1", "test-mw", bundle).generation(); got != 3 {
+		t.Errorf("generation() = %d, expected the version 3", got)
+	}
+}
+
+func TestListManifestWorksPagesHTTP(t *testing.T) {
+	const total = 5
+	var requestedPages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		size, _ := strconv.Atoi(r.URL.Query().Get("size"))
+		requestedPages = append(requestedPages, r.URL.Query().Get("page"))
+
+		items := []interface{}{}
+		for i := (page - 1) * size; i < page*size && i < total; i++ {
+			items = append(items, map[string]interface{}{
+				"id":       fmt.Sprintf("id-%d", i),
+				"metadata": map[string]interface{}{"name": fmt.Sprintf("work-%d", i)},
+			})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"kind":  "ResourceBundleList",
+			"page":  page,
+			"size":  len(items),
+			"total": total,
+			"items": items,
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewHTTPClient(ClientConfig{HTTPEndpoint: server.URL})
+	if err != nil {
+		t.Fatalf("NewHTTPClient() unexpected error: %v", err)
+	}
+
+	var pageSizes []int
+	var names []string
+	err = client.ListManifestWorksPagesHTTP(context.Background(), "agent1", 2, func(page []ResourceBundleSummary) error {
+		pageSizes = append(pageSizes, len(page))
+		for _, work := range page {
+			names = append(names, work.Name)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ListManifestWorksPagesHTTP() unexpected error: %v", err)
+	}
+
+	if fmt.Sprint(pageSizes) != "[2 2 1]" {
+		t.Errorf("expected pages of [2 2 1], got %v (requested pages %v)", pageSizes, requestedPages)
+	}
+	if len(names) != total || names[0] != "work-0" || names[total-1] != "work-4" {
+		t.Errorf("expected all %d works in order, got %v", total, names)
+	}
+
+	// Stopping early returns the callback error without fetching further pages
+	requestedPages = nil
+	stop := errors.New("stop")
+	err = client.ListManifestWorksPagesHTTP(context.Background(), "agent1", 2, func([]ResourceBundleSummary) error {
+		return stop
+	})
+	if !errors.Is(err, stop) || len(requestedPages) != 1 {
+		t.Errorf("expected iteration to stop after first page, got err=%v pages=%v", err, requestedPages)
+	}
+}
+
+func TestManifestWorkChanged(t *testing.T) {
+	work := func(labels map[string]string, manifest string) *workv1.ManifestWork {
+		mw := &workv1.ManifestWork{}
+		mw.Name = "work"
+		mw.Labels = labels
+		mw.Spec.Workload.Manifests = []workv1.Manifest{{RawExtension: runtime.RawExtension{Raw: []byte(manifest)}}}
+		return mw
+	}
+	existing := work(map[string]string{"app": "nginx"}, `{"kind":"ConfigMap","metadata":{"name":"config"}}`)
+
 	tests := []struct {
 		name     string
-		expr     string
-		op1      string
-		op2      string
-		expected []string
+		desired  *workv1.ManifestWork
+		expected bool
 	}{
 		{
-			name:     "spaced operators - AND",
-			expr:     "A && B",
-			op1:      "AND",
-			op2:      "&&",
-			expected: []string{"A", "B"},
-		},
-		{
-			name:     "spaced operators - OR",
-			expr:     "A || B",
-			op1:      "OR",
-			op2:      "||",
-			expected: []string{"A", "B"},
+			name:    "same content with different key order and whitespace",
+			desired: work(map[string]string{"app": "nginx"}, `{"metadata": {"name": "config"}, "kind": "ConfigMap"}`),
 		},
 		{
-			name:     "compact operators - AND",
-			expr:     "A&&B",
-			op1:      "AND",
-			op2:      "&&",
-			expected: []string{"A", "B"},
+			name:     "manifest changed",
+			desired:  work(map[string]string{"app": "nginx"}, `{"kind":"ConfigMap","metadata":{"name":"other"}}`),
+			expected: true,
 		},
 		{
-			name:     "compact operators - OR",
-			expr:     "A||B",
-			op1:      "OR",
-			op2:      "||",
-			expected: []string{"A", "B"},
+			name:     "labels changed",
+			desired:  work(nil, `{"kind":"ConfigMap","metadata":{"name":"config"}}`),
+			expected: true,
 		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changed, err := manifestWorkChanged(existing, tt.desired)
+			if err != nil {
+				t.Fatalf("manifestWorkChanged() unexpected error: %v", err)
+			}
+			if changed != tt.expected {
+				t.Errorf("manifestWorkChanged() = %v, expected %v", changed, tt.expected)
+			}
+		})
+	}
+
+	// A work applied by maestro-cli is compared by its spec hash, ignoring the annotation itself
+	hashed := work(map[string]string{"app": "nginx"}, `{"kind":"ConfigMap","metadata":{"name":"config"}}`)
+	if err := setSpecHash(hashed); err != nil {
+		t.Fatalf("setSpecHash() unexpected error: %v", err)
+	}
+	stale := work(map[string]string{"app": "nginx"}, `{"kind":"ConfigMap","metadata":{"name":"edited"}}`)
+	stale.Annotations = hashed.Annotations
+	hashTests := []struct {
+		name     string
+		existing *workv1.ManifestWork
+		desired  *workv1.ManifestWork
+		expected bool
+	}{
 		{
-			name:     "mixed operators",
-			expr:     "A && B || C",
-			op1:      "OR",
-			op2:      "||",
-			expected: []string{"A && B", "C"},
+			name:     "spec hash annotation missing from existing work",
+			existing: existing,
+			desired:  hashed,
 		},
 		{
-			name:     "no operators",
-			expr:     "single condition",
-			op1:      "AND",
-			op2:      "&&",
-			expected: []string{"single condition"},
+			name:     "same spec hash",
+			existing: hashed,
+			desired:  work(map[string]string{"app": "nginx"}, `{"metadata":{"name":"config"},"kind":"ConfigMap"}`),
 		},
 		{
-			name:     "parentheses",
-			expr:     "(A && B) || C",
-			op1:      "OR",
-			op2:      "||",
-			expected: []string{"(A && B)", "C"},
+			name:     "different spec hash",
+			existing: hashed,
+			desired:  work(map[string]string{"app": "nginx"}, `{"kind":"ConfigMap","metadata":{"name":"other"}}`),
+			expected: true,
 		},
 		{
-			name:     "compact at end",
-			expr:     "A&&B",
-			op1:      "AND",
-			op2:      "&&",
-			expected: []string{"A", "B"},
+			// The spec was edited without updating the hash; only --force updates such a work
+			name:     "stored spec hash is trusted",
+			existing: stale,
+			desired:  work(map[string]string{"app": "nginx"}, `{"kind":"ConfigMap","metadata":{"name":"config"}}`),
 		},
-		{
-			name:     "multiple compact operators",
-			expr:     "A&&B&&C",
-			op1:      "AND",
-			op2:      "&&",
-			expected: []string{"A", "B", "C"},
+	}
+
+	for _, tt := range hashTests {
+		t.Run(tt.name, func(t *testing.T) {
+			changed, err := manifestWorkChanged(tt.existing, tt.desired)
+			if err != nil {
+				t.Fatalf("manifestWorkChanged() unexpected error: %v", err)
+			}
+			if changed != tt.expected {
+				t.Errorf("manifestWorkChanged() = %v, expected %v", changed, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWaitForConditionFailOn(t *testing.T) {
+	bundle := map[string]interface{}{
+		"id":            "3f1c2a9e-0000-0000-0000-000000000004",
+		"consumer_name": "agent1",
+		"version":       1,
+		"metadata":      map[string]interface{}{"name": "test-mw"},
+		"status": map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Applied", "status": "True"},
+				map[string]interface{}{"type": "Available", "status": "True"},
+				map[string]interface{}{"type": "Degraded", "status": "True"},
+			},
 		},
 	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"kind":  "ResourceBundleList",
+			"page":  1,
+			"size":  1,
+			"total": 1,
+			"items": []interface{}{bundle},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewHTTPClient(ClientConfig{HTTPEndpoint: server.URL})
+	if err != nil {
+		t.Fatalf("NewHTTPClient() unexpected error: %v", err)
+	}
+	log := logger.New(logger.Config{Level: "error", Format: "text"})
 
+	tests := []struct {
+		name        string
+		failOn      string
+		wantMatched string // empty when the wait succeeds
+	}{
+		{name: "no fail-on"},
+		{name: "fail-on not met", failOn: "Progressing"},
+		{name: "fail-on met", failOn: "Progressing OR Degraded", wantMatched: "Degraded"},
+	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := splitByOperator(tt.expr, tt.op1, tt.op2)
-			if len(result) != len(tt.expected) {
-				t.Errorf("splitByOperator(%q, %q, %q) length = %d, expected %d",
-					tt.expr, tt.op1, tt.op2, len(result), len(tt.expected),
-				)
-				return
+			var conditionMet *bool
+			callback := func(_ *ManifestWorkDetails, met bool) error {
+				conditionMet = &met
+				return nil
+			}
+			err := client.WaitForConditionWithOptions(context.Background(), "agent1", "test-mw", "Available",
+				WaitOptions{FailOn: tt.failOn}, log, callback)
+			if conditionMet == nil || *conditionMet != (tt.wantMatched == "") {
+				t.Errorf("callback conditionMet = %v, expected %v", conditionMet, tt.wantMatched == "")
 			}
-			for i, expected := range tt.expected {
-				if result[i] != expected {
-					t.Errorf("splitByOperator(%q, %q, %q)[%d] = %q, expected %q", tt.expr, tt.op1, tt.op2, i, result[i], expected)
+			if tt.wantMatched == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
 				}
+				return
+			}
+			var failedErr *ConditionFailedError
+			if !errors.As(err, &failedErr) {
+				t.Fatalf("expected ConditionFailedError, got %v", err)
+			}
+			if failedErr.Matched != tt.wantMatched {
+				t.Errorf("matched = %q, expected %q", failedErr.Matched, tt.wantMatched)
 			}
+			if got := clierrors.FromError(err).Type; got != clierrors.TypeConditionFailed {
+				t.Errorf("expected error type %s, got %s", clierrors.TypeConditionFailed, got)
+			}
+		})
+	}
+}
+
+func TestWaitForConditionTimeoutTrace(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"kind":  "ResourceBundleList",
+			"page":  1,
+			"size":  1,
+			"total": 1,
+			"items": []interface{}{map[string]interface{}{
+				"id":            "3f1c2a9e-0000-0000-0000-000000000005",
+				"consumer_name": "agent1",
+				"version":       1,
+				"metadata":      map[string]interface{}{"name": "test-mw"},
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Applied", "status": "True"},
+						map[string]interface{}{"type": "Available", "status": "False"},
+					},
+				},
+			}},
 		})
+	}))
+	defer server.Close()
+
+	client, err := NewHTTPClient(ClientConfig{HTTPEndpoint: server.URL})
+	if err != nil {
+		t.Fatalf("NewHTTPClient() unexpected error: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	err = client.WaitForCondition(ctx, "agent1", "test-mw", "Available", 20*time.Millisecond,
+		logger.New(logger.Config{Level: "error", Format: "text"}), nil)
+	var unmetErr *ConditionUnmetError
+	if !errors.As(err, &unmetErr) {
+		t.Fatalf("expected ConditionUnmetError, got %v", err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the error to wrap context.DeadlineExceeded, got %v", err)
+	}
+	if got := clierrors.FromError(err).Type; got != clierrors.TypeTimeout {
+		t.Errorf("expected error type %s, got %s", clierrors.TypeTimeout, got)
+	}
+	want := "✗ Available\n  ✗ ManifestWork: Available is False\n"
+	if unmetErr.Trace == nil || unmetErr.Trace.Tree() != want {
+		t.Errorf("unexpected trace %+v, expected tree %q", unmetErr.Trace, want)
 	}
 }
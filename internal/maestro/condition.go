@@ -0,0 +1,830 @@
+package maestro
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	clierrors "github.com/openshift-hyperfleet/maestro-cli/pkg/errors"
+	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
+)
+
+// Condition is a compiled condition expression used by --wait and --for.
+// It is parsed once with ParseCondition and can then be evaluated on every poll.
+//
+// Grammar (lowest to highest precedence):
+//
+//	expr    := or
+//	or      := and { ("OR" | "||") and }
+//	and     := unary { ("AND" | "&&") unary }
+//	unary   := ("NOT" | "!") unary | primary [ "for" DURATION ]
+//	primary := "(" expr ")" | leaf
+//	leaf    := IDENT [ "=" STATUS ]                   ManifestWork condition, e.g. Available or Available=False
+//	         | SELECTOR ":" check                     resource condition, e.g. Job:Complete
+//	         | ("all" | "any") "(" SELECTOR ")" ":" check
+//	         | "count" "(" SELECTOR ":" check ")" OP NUMBER
+//	check   := CONDITION | PATH OP VALUE | "length" "(" PATH ")" OP VALUE
+//
+// SELECTOR is Kind, Kind/name or Kind/namespace/name, where each segment may be a glob pattern
+// such as prod-*, optionally followed by a label selector in braces, e.g. Deployment{app=web}.
+// A bare SELECTOR is satisfied when any resource it matches satisfies the check; all() needs
+// every matching resource to and count() compares how many do. PATH is a status feedback path,
+// a JSONPath subset with indices, wildcards and filters (see fieldPath). OP is one of =, !=, >=,
+// <=, > and <, or =~ for an unanchored regular expression match.
+// VALUE may be a bare word or a single/double quoted string literal.
+// "for DURATION" (e.g. "Available for 30s") is a stability window: the operand must hold
+// continuously for DURATION, so a Condition with windows keeps state between evaluations.
+type Condition struct {
+	expr    string
+	root    conditionNode
+	windows []*stableNode // innermost first
+}
+
+// ConditionSyntaxError reports an invalid condition expression with the 1-based column of the problem
+type ConditionSyntaxError struct {
+	Expr    string
+	Column  int
+	Message string
+}
+
+// Error implements the error interface
+func (e *ConditionSyntaxError) Error() string {
+	return fmt.Sprintf("invalid condition %q at column %d: %s", e.Expr, e.Column, e.Message)
+}
+
+// ErrorType classifies condition syntax errors as validation failures
+func (e *ConditionSyntaxError) ErrorType() clierrors.Type {
+	return clierrors.TypeValidationFailed
+}
+
+// ConditionFailedError reports that the --fail-on condition of a wait became true
+// Matched is the sub-expression that made it true, e.g. Job:Failed of "Job:Failed OR Degraded".
+type ConditionFailedError struct {
+	Name    string
+	FailOn  string
+	Matched string
+}
+
+// Error implements the error interface
+func (e *ConditionFailedError) Error() string {
+	return fmt.Sprintf("ManifestWork %s met fail-on condition %q: %s", e.Name, e.FailOn, e.Matched)
+}
+
+// ErrorType classifies a met fail-on condition as a failed condition
+func (e *ConditionFailedError) ErrorType() clierrors.Type {
+	return clierrors.TypeConditionFailed
+}
+
+// ParseCondition compiles a condition expression into an evaluable Condition
+func ParseCondition(expr string) (*Condition, error) {
+	tokens, err := tokenizeCondition(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &conditionParser{expr: expr, tokens: tokens}
+	if p.peek().kind == tokEOF {
+		return nil, p.errorf(p.peek(), "empty condition expression")
+	}
+
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokEOF {
+		return nil, p.errorf(tok, "unexpected %s, expected AND, OR or end of expression", tok.describe())
+	}
+
+	return &Condition{expr: strings.TrimSpace(expr), root: root, windows: p.windows}, nil
+}
+
+// String returns the original expression
+func (c *Condition) String() string {
+	return c.expr
+}
+
+// Evaluate evaluates the compiled expression against the current ManifestWork details
+// Stability windows are updated with details as observed now.
+func (c *Condition) Evaluate(ctx context.Context, details *ManifestWorkDetails, log *logger.Logger) bool {
+	if c == nil || c.root == nil || details == nil {
+		return false
+	}
+	c.observe(ctx, details, log, time.Now())
+	return c.root.eval(ctx, details, log)
+}
+
+// Match evaluates the expression like Evaluate and also returns the sub-expression that made it true
+// The operands of OR are tried in order and the first true one is returned, so "Job:Failed OR Degraded"
+// reports Job:Failed or Degraded; any other expression is returned as a whole.
+func (c *Condition) Match(ctx context.Context, details *ManifestWorkDetails, log *logger.Logger) (string, bool) {
+	if c == nil || c.root == nil || details == nil {
+		return "", false
+	}
+	c.observe(ctx, details, log, time.Now())
+	return matchNode(ctx, c.root, details, log)
+}
+
+// matchNode evaluates node and returns the operand of an OR that is true, or node itself
+func matchNode(
+	ctx context.Context,
+	node conditionNode,
+	details *ManifestWorkDetails,
+	log *logger.Logger,
+) (string, bool) {
+	if or, ok := node.(*orNode); ok {
+		if matched, ok := matchNode(ctx, or.left, details, log); ok {
+			return matched, true
+		}
+		return matchNode(ctx, or.right, details, log)
+	}
+	if !node.eval(ctx, details, log) {
+		return "", false
+	}
+	return node.String(), true
+}
+
+// Trace evaluates the expression and returns a trace explaining the result of every sub-expression
+// Unlike Evaluate, it does not short-circuit, so every leaf is explained, and it leaves stability
+// windows as they were at the last evaluation.
+func (c *Condition) Trace(ctx context.Context, details *ManifestWorkDetails, log *logger.Logger) *ConditionTrace {
+	if c == nil || c.root == nil || details == nil {
+		return nil
+	}
+	trace := c.root.trace(ctx, details, log)
+	trace.Expr = c.expr
+	return trace
+}
+
+// conditionNode is a node of the compiled condition AST
+type conditionNode interface {
+	eval(ctx context.Context, details *ManifestWorkDetails, log *logger.Logger) bool
+	trace(ctx context.Context, details *ManifestWorkDetails, log *logger.Logger) *ConditionTrace
+	String() string
+}
+
+// andNode is true when both operands are true (short-circuit)
+type andNode struct {
+	left, right conditionNode
+}
+
+func (n *andNode) eval(ctx context.Context, details *ManifestWorkDetails, log *logger.Logger) bool {
+	return n.left.eval(ctx, details, log) && n.right.eval(ctx, details, log)
+}
+
+func (n *andNode) trace(ctx context.Context, details *ManifestWorkDetails, log *logger.Logger) *ConditionTrace {
+	left, right := n.left.trace(ctx, details, log), n.right.trace(ctx, details, log)
+	return &ConditionTrace{Expr: n.String(), Result: left.Result && right.Result, Operands: []*ConditionTrace{left, right}}
+}
+
+func (n *andNode) String() string {
+	return fmt.Sprintf("(%s AND %s)", n.left, n.right)
+}
+
+// orNode is true when either operand is true (short-circuit)
+type orNode struct {
+	left, right conditionNode
+}
+
+func (n *orNode) eval(ctx context.Context, details *ManifestWorkDetails, log *logger.Logger) bool {
+	return n.left.eval(ctx, details, log) || n.right.eval(ctx, details, log)
+}
+
+func (n *orNode) trace(ctx context.Context, details *ManifestWorkDetails, log *logger.Logger) *ConditionTrace {
+	left, right := n.left.trace(ctx, details, log), n.right.trace(ctx, details, log)
+	return &ConditionTrace{Expr: n.String(), Result: left.Result || right.Result, Operands: []*ConditionTrace{left, right}}
+}
+
+func (n *orNode) String() string {
+	return fmt.Sprintf("(%s OR %s)", n.left, n.right)
+}
+
+// notNode negates its operand
+type notNode struct {
+	operand conditionNode
+}
+
+func (n *notNode) eval(ctx context.Context, details *ManifestWorkDetails, log *logger.Logger) bool {
+	return !n.operand.eval(ctx, details, log)
+}
+
+func (n *notNode) trace(ctx context.Context, details *ManifestWorkDetails, log *logger.Logger) *ConditionTrace {
+	operand := n.operand.trace(ctx, details, log)
+	return &ConditionTrace{Expr: n.String(), Result: !operand.Result, Operands: []*ConditionTrace{operand}}
+}
+
+func (n *notNode) String() string {
+	return fmt.Sprintf("NOT %s", n.operand)
+}
+
+// workConditionNode checks a ManifestWork-level condition such as Available or Applied
+type workConditionNode struct {
+	condType string
+	status   string // awaited status other than True, e.g. False for Available=False
+}
+
+func (n *workConditionNode) eval(ctx context.Context, details *ManifestWorkDetails, log *logger.Logger) bool {
+	return checkDetailsCondition(ctx, details, n.condType, n.wantedStatus(), nil, log)
+}
+
+// wantedStatus returns the awaited status of the condition
+func (n *workConditionNode) wantedStatus() string {
+	if n.status == "" {
+		return statusTrue
+	}
+	return n.status
+}
+
+func (n *workConditionNode) trace(
+	ctx context.Context,
+	details *ManifestWorkDetails,
+	log *logger.Logger,
+) *ConditionTrace {
+	trace := &ConditionTrace{Expr: n.String()}
+	trace.Result = checkDetailsCondition(ctx, details, n.condType, n.wantedStatus(), trace, log)
+	return trace
+}
+
+func (n *workConditionNode) String() string {
+	if n.status == "" {
+		return n.condType
+	}
+	return fmt.Sprintf("%s=%s", n.condType, quoteConditionValue(n.status))
+}
+
+// Quantifiers of resource conditions over the resources matched by their selector
+const (
+	quantifierAny   = "any"
+	quantifierAll   = "all"
+	quantifierCount = "count"
+)
+
+// resourceConditionNode checks a condition or status feedback value of resources in the ManifestWork
+type resourceConditionNode struct {
+	quantifier    string // empty for a bare selector, which behaves like any
+	selector      resourceSelector
+	check         string
+	path          *fieldPath // nil for condition checks
+	operator      string     // empty for condition checks
+	value         string
+	countOperator string // count() only: comparison of the number of resources satisfying the check
+	count         int
+}
+
+func (n *resourceConditionNode) eval(ctx context.Context, details *ManifestWorkDetails, log *logger.Logger) bool {
+	return evaluateStatusFeedbackCondition(ctx, details, n, nil, log)
+}
+
+func (n *resourceConditionNode) trace(
+	ctx context.Context,
+	details *ManifestWorkDetails,
+	log *logger.Logger,
+) *ConditionTrace {
+	trace := &ConditionTrace{Expr: n.String()}
+	trace.Result = evaluateStatusFeedbackCondition(ctx, details, n, trace, log)
+	return trace
+}
+
+func (n *resourceConditionNode) String() string {
+	check := n.check
+	if n.operator != "" {
+		check += n.operator + quoteConditionValue(n.value)
+	}
+	switch n.quantifier {
+	case quantifierCount:
+		return fmt.Sprintf("count(%s:%s)%s%d", n.selector, check, n.countOperator, n.count)
+	case quantifierAll, quantifierAny:
+		return fmt.Sprintf("%s(%s):%s", n.quantifier, n.selector, check)
+	default:
+		return fmt.Sprintf("%s:%s", n.selector, check)
+	}
+}
+
+// resourceSelector identifies resources by kind and optionally name, namespace and labels
+// Kind, Namespace and Name are case-insensitive glob patterns, e.g. Deployment/prod-*/web. Kind
+// also matches the plural resource name, so jobs/pi selects the Job pi like kubectl does.
+type resourceSelector struct {
+	Kind      string
+	Namespace string
+	Name      string
+	Labels    labels.Selector // nil when the selector has no label selector
+}
+
+// String returns the selector in Kind[/namespace]/name[{labels}] form
+func (s resourceSelector) String() string {
+	var selector string
+	switch {
+	case s.Namespace != "":
+		selector = fmt.Sprintf("%s/%s/%s", s.Kind, s.Namespace, s.Name)
+	case s.Name != "":
+		selector = fmt.Sprintf("%s/%s", s.Kind, s.Name)
+	default:
+		selector = s.Kind
+	}
+	if s.Labels != nil {
+		selector += "{" + s.Labels.String() + "}"
+	}
+	return selector
+}
+
+// matches reports whether the selector matches the resource status rs of the ManifestWork in details
+// The labels of a resource are those of its manifest in the ManifestWork spec.
+func (s resourceSelector) matches(rs ResourceStatusInfo, details *ManifestWorkDetails) bool {
+	if (!matchPattern(s.Kind, rs.Kind) && (rs.Resource == "" || !matchPattern(s.Kind, rs.Resource))) ||
+		(s.Namespace != "" && !matchPattern(s.Namespace, rs.Namespace)) ||
+		(s.Name != "" && !matchPattern(s.Name, rs.Name)) {
+		return false
+	}
+	return s.Labels == nil || s.Labels.Matches(labels.Set(manifestLabels(details, rs)))
+}
+
+// matchPattern reports whether value matches the glob pattern, ignoring case
+func matchPattern(pattern, value string) bool {
+	matched, err := path.Match(strings.ToLower(pattern), strings.ToLower(value))
+	return err == nil && matched
+}
+
+// manifestLabels returns the labels of the manifest of the resource status rs, if any
+// A manifest without namespace matches the resource applied from it in any namespace.
+func manifestLabels(details *ManifestWorkDetails, rs ResourceStatusInfo) map[string]string {
+	for _, manifest := range details.Manifests {
+		if strings.EqualFold(manifest.Kind, rs.Kind) && manifest.Name == rs.Name &&
+			(manifest.Namespace == "" || manifest.Namespace == rs.Namespace) {
+			return manifest.Labels
+		}
+	}
+	return nil
+}
+
+// quoteConditionValue quotes a value if it cannot be written as a bare word
+func quoteConditionValue(value string) string {
+	if value == "" || strings.ContainsAny(value, " \t\"'()!&|:<>=[{") {
+		return fmt.Sprintf("%q", value)
+	}
+	return value
+}
+
+// tokenKind identifies the type of a lexical token
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokString
+	tokLParen
+	tokRParen
+	tokAnd
+	tokOr
+	tokNot
+	tokColon
+	tokOperator
+)
+
+// token is a lexical token with its 1-based column in the expression
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// describe returns a human-readable description of the token for error messages
+func (t token) describe() string {
+	switch t.kind {
+	case tokEOF:
+		return "end of expression"
+	case tokString:
+		return fmt.Sprintf("string %q", t.text)
+	case tokIdent:
+		return fmt.Sprintf("%q", t.text)
+	default:
+		return fmt.Sprintf("'%s'", t.text)
+	}
+}
+
+// conditionDelimiters are characters that terminate a bare word
+const conditionDelimiters = "()!&|:<>=\"'"
+
+// tokenizeCondition splits a condition expression into tokens
+func tokenizeCondition(expr string) ([]token, error) {
+	var tokens []token
+	syntaxErr := func(pos int, format string, args ...interface{}) error {
+		return &ConditionSyntaxError{Expr: expr, Column: pos + 1, Message: fmt.Sprintf(format, args...)}
+	}
+
+	i := 0
+	for i < len(expr) {
+		ch := expr[i]
+		switch {
+		case ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r':
+			i++
+		case ch == '(':
+			tokens = append(tokens, token{kind: tokLParen, text: "(", pos: i + 1})
+			i++
+		case ch == ')':
+			tokens = append(tokens, token{kind: tokRParen, text: ")", pos: i + 1})
+			i++
+		case ch == ':':
+			tokens = append(tokens, token{kind: tokColon, text: ":", pos: i + 1})
+			i++
+		case ch == '!' && strings.HasPrefix(expr[i:], "!="):
+			tokens = append(tokens, token{kind: tokOperator, text: "!=", pos: i + 1})
+			i += 2
+		case ch == '!':
+			tokens = append(tokens, token{kind: tokNot, text: "!", pos: i + 1})
+			i++
+		case ch == '&':
+			if !strings.HasPrefix(expr[i:], "&&") {
+				return nil, syntaxErr(i, "unexpected '&', did you mean '&&'?")
+			}
+			tokens = append(tokens, token{kind: tokAnd, text: "&&", pos: i + 1})
+			i += 2
+		case ch == '|':
+			if !strings.HasPrefix(expr[i:], "||") {
+				return nil, syntaxErr(i, "unexpected '|', did you mean '||'?")
+			}
+			tokens = append(tokens, token{kind: tokOr, text: "||", pos: i + 1})
+			i += 2
+		case ch == '>' || ch == '<':
+			op := string(ch)
+			if i+1 < len(expr) && expr[i+1] == '=' {
+				op += "="
+			}
+			tokens = append(tokens, token{kind: tokOperator, text: op, pos: i + 1})
+			i += len(op)
+		case ch == '=':
+			op := "="
+			if strings.HasPrefix(expr[i:], "=~") {
+				op = "=~"
+			}
+			tokens = append(tokens, token{kind: tokOperator, text: op, pos: i + 1})
+			i += len(op)
+		case ch == '"' || ch == '\'':
+			value, next, ok := scanQuoted(expr, i)
+			if !ok {
+				return nil, syntaxErr(i, "unterminated string literal")
+			}
+			tokens = append(tokens, token{kind: tokString, text: value, pos: i + 1})
+			i = next
+		default:
+			start := i
+			for i < len(expr) && !isConditionSpace(expr[i]) && !strings.ContainsRune(conditionDelimiters, rune(expr[i])) {
+				// A label selector such as {app=web,tier!=cache} is part of the word
+				if expr[i] == '{' {
+					end := strings.IndexByte(expr[i:], '}')
+					if end < 0 {
+						return nil, syntaxErr(i, "unterminated label selector, expected '}'")
+					}
+					i += end
+				}
+				// So is the index or filter of a path such as conditions[?(@.type=="Ready")]
+				if expr[i] == '[' {
+					end := closingBracket(expr, i)
+					if end < 0 {
+						return nil, syntaxErr(i, "unterminated '[', expected ']'")
+					}
+					i = end
+				}
+				i++
+			}
+			word := expr[start:i]
+			tok := token{kind: tokIdent, text: word, pos: start + 1}
+			switch word {
+			case "AND":
+				tok.kind = tokAnd
+			case "OR":
+				tok.kind = tokOr
+			case "NOT":
+				tok.kind = tokNot
+			}
+			tokens = append(tokens, tok)
+		}
+	}
+
+	tokens = append(tokens, token{kind: tokEOF, pos: len(expr) + 1})
+	return tokens, nil
+}
+
+// isConditionSpace reports whether ch separates tokens
+func isConditionSpace(ch byte) bool {
+	return ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r'
+}
+
+// scanQuoted scans a quoted string starting at expr[start] and returns the unescaped value
+// and the index just past the closing quote. Backslash escapes the next character.
+func scanQuoted(expr string, start int) (string, int, bool) {
+	quote := expr[start]
+	var sb strings.Builder
+	for i := start + 1; i < len(expr); i++ {
+		switch expr[i] {
+		case '\\':
+			if i+1 < len(expr) {
+				i++
+				sb.WriteByte(expr[i])
+			}
+		case quote:
+			return sb.String(), i + 1, true
+		default:
+			sb.WriteByte(expr[i])
+		}
+	}
+	return "", 0, false
+}
+
+// conditionParser is a recursive-descent parser over condition tokens
+type conditionParser struct {
+	expr    string
+	tokens  []token
+	pos     int
+	windows []*stableNode
+}
+
+func (p *conditionParser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *conditionParser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *conditionParser) errorf(tok token, format string, args ...interface{}) error {
+	return &ConditionSyntaxError{Expr: p.expr, Column: tok.pos, Message: fmt.Sprintf(format, args...)}
+}
+
+func (p *conditionParser) parseOr() (conditionNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokOr {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &orNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *conditionParser) parseAnd() (conditionNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokAnd {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &andNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *conditionParser) parseUnary() (conditionNode, error) {
+	if p.peek().kind == tokNot {
+		p.next()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{operand: operand}, nil
+	}
+	primary, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokIdent || (tok.text != "for" && tok.text != "FOR") {
+		return primary, nil
+	}
+	return p.parseWindow(primary)
+}
+
+// parseWindow parses the "for DURATION" stability window of operand
+func (p *conditionParser) parseWindow(operand conditionNode) (conditionNode, error) {
+	keyword := p.next()
+	tok := p.next()
+	if tok.kind != tokIdent {
+		return nil, p.errorf(tok, "expected duration after '%s', found %s", keyword.text, tok.describe())
+	}
+	duration, err := time.ParseDuration(tok.text)
+	if err != nil || duration <= 0 {
+		return nil, p.errorf(tok, "invalid duration %q, expected a positive duration such as 30s or 2m", tok.text)
+	}
+	window := &stableNode{operand: operand, duration: duration, text: tok.text}
+	p.windows = append(p.windows, window)
+	return window, nil
+}
+
+func (p *conditionParser) parsePrimary() (conditionNode, error) {
+	tok := p.peek()
+	switch tok.kind {
+	case tokLParen:
+		p.next()
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.peek(); closing.kind != tokRParen {
+			return nil, p.errorf(closing, "expected ')' to close '(' at column %d, found %s", tok.pos, closing.describe())
+		}
+		p.next()
+		return node, nil
+	case tokIdent:
+		if p.tokens[p.pos+1].kind == tokLParen && isQuantifier(tok.text) {
+			return p.parseQuantifier()
+		}
+		return p.parseLeaf()
+	default:
+		return nil, p.errorf(tok, "expected condition, found %s", tok.describe())
+	}
+}
+
+// parseLeaf parses a ManifestWork condition or a resource condition/comparison
+func (p *conditionParser) parseLeaf() (conditionNode, error) {
+	first := p.next()
+	if p.peek().kind != tokColon {
+		node := &workConditionNode{condType: first.text}
+		if op := p.peek(); op.kind == tokOperator && op.text == "=" {
+			p.next()
+			status := p.next()
+			if status.kind != tokIdent && status.kind != tokString {
+				return nil, p.errorf(status, "expected condition status after '=', found %s", status.describe())
+			}
+			if !strings.EqualFold(status.text, statusTrue) {
+				node.status = status.text
+			}
+		} else if op.kind == tokOperator {
+			return nil, p.errorf(op,
+				"comparison '%s' requires a resource selector (e.g. Job:succeeded>=1)", op.text)
+		}
+		return node, nil
+	}
+	return p.parseResourceCheck(first)
+}
+
+// isQuantifier reports whether word is all, any or count, in lower or upper case
+func isQuantifier(word string) bool {
+	switch strings.ToLower(word) {
+	case quantifierAll, quantifierAny, quantifierCount:
+		return word == strings.ToLower(word) || word == strings.ToUpper(word)
+	default:
+		return false
+	}
+}
+
+// parseQuantifier parses all(SELECTOR):CHECK, any(SELECTOR):CHECK or count(SELECTOR:CHECK) OP NUMBER
+func (p *conditionParser) parseQuantifier() (conditionNode, error) {
+	keyword := p.next()
+	quantifier := strings.ToLower(keyword.text)
+	p.next() // consume '('
+
+	selector := p.next()
+	if selector.kind != tokIdent {
+		return nil, p.errorf(selector, "expected resource selector after '%s(', found %s",
+			keyword.text, selector.describe())
+	}
+
+	if quantifier != quantifierCount {
+		if closing := p.next(); closing.kind != tokRParen {
+			return nil, p.errorf(closing, "expected ')' after resource selector, found %s", closing.describe())
+		}
+		node, err := p.parseResourceCheck(selector)
+		if err != nil {
+			return nil, err
+		}
+		node.quantifier = quantifier
+		return node, nil
+	}
+
+	node, err := p.parseResourceCheck(selector)
+	if err != nil {
+		return nil, err
+	}
+	node.quantifier = quantifier
+	if closing := p.next(); closing.kind != tokRParen {
+		return nil, p.errorf(closing, "expected ')' to close '%s(', found %s", keyword.text, closing.describe())
+	}
+	op := p.next()
+	if op.kind != tokOperator || op.text == "=~" {
+		return nil, p.errorf(op, "expected comparison after '%s(...)' (e.g. %s(Job:Complete)>=3), found %s",
+			keyword.text, keyword.text, op.describe())
+	}
+	count := p.next()
+	n, err := strconv.Atoi(count.text)
+	if count.kind != tokIdent || err != nil || n < 0 {
+		return nil, p.errorf(count, "expected a number of resources after '%s', found %s", op.text, count.describe())
+	}
+	node.countOperator = op.text
+	node.count = n
+	return node, nil
+}
+
+// parseResourceCheck parses the ":" check following the resource selector in selectorTok
+func (p *conditionParser) parseResourceCheck(selectorTok token) (*resourceConditionNode, error) {
+	selector, err := parseResourceSelector(selectorTok.text)
+	if err != nil {
+		return nil, p.errorf(selectorTok, "%s", err.Error())
+	}
+	if colon := p.next(); colon.kind != tokColon {
+		return nil, p.errorf(colon, "expected ':' and a condition or field after %q, found %s",
+			selectorTok.text, colon.describe())
+	}
+
+	check := p.next()
+	if check.kind != tokIdent && check.kind != tokString {
+		return nil, p.errorf(check, "expected condition or field after ':', found %s", check.describe())
+	}
+	node := &resourceConditionNode{selector: selector, check: check.text}
+
+	if check.kind == tokIdent && check.text == "length" && p.peek().kind == tokLParen {
+		open := p.next()
+		field := p.next()
+		if field.kind != tokIdent && field.kind != tokString {
+			return nil, p.errorf(field, "expected path after 'length(', found %s", field.describe())
+		}
+		if closing := p.next(); closing.kind != tokRParen {
+			return nil, p.errorf(closing, "expected ')' to close '(' at column %d, found %s", open.pos, closing.describe())
+		}
+		if op := p.peek(); op.kind != tokOperator {
+			return nil, p.errorf(op, "expected comparison after 'length(...)', found %s", op.describe())
+		}
+		node.check = "length(" + field.text + ")"
+	}
+
+	if p.peek().kind != tokOperator {
+		return node, nil
+	}
+	op := p.next()
+	value := p.next()
+	if value.kind != tokIdent && value.kind != tokString {
+		return nil, p.errorf(value, "expected value after '%s', found %s", op.text, value.describe())
+	}
+	compiled, err := parseFieldPath(node.check)
+	if err != nil {
+		return nil, p.errorf(check, "%s", err.Error())
+	}
+	if op.text == "=~" {
+		if _, err := regexp.Compile(value.text); err != nil {
+			return nil, p.errorf(value, "invalid regular expression %q: %v", value.text, err)
+		}
+	}
+	node.path = compiled
+	node.operator = op.text
+	node.value = value.text
+	return node, nil
+}
+
+// parseResourceSelector parses Kind, Kind/name or Kind/namespace/name, optionally followed by {labels}
+func parseResourceSelector(selector string) (resourceSelector, error) {
+	var labelSelector labels.Selector
+	patterns := selector
+	if start := strings.IndexByte(selector, '{'); start >= 0 {
+		if !strings.HasSuffix(selector, "}") {
+			return resourceSelector{}, fmt.Errorf(
+				"invalid resource selector %q, the label selector must come last", selector)
+		}
+		patterns = selector[:start]
+		text := strings.TrimSpace(selector[start+1 : len(selector)-1])
+		if text == "" {
+			return resourceSelector{}, fmt.Errorf("empty label selector in resource selector %q", selector)
+		}
+		parsed, err := labels.Parse(text)
+		if err != nil {
+			return resourceSelector{}, fmt.Errorf("invalid label selector in resource selector %q: %w", selector, err)
+		}
+		labelSelector = parsed
+	}
+
+	parts := strings.Split(patterns, "/")
+	for _, part := range parts {
+		if part == "" {
+			return resourceSelector{}, fmt.Errorf("invalid resource selector %q", selector)
+		}
+		if _, err := path.Match(part, ""); err != nil {
+			return resourceSelector{}, fmt.Errorf("invalid pattern %q in resource selector %q", part, selector)
+		}
+	}
+
+	switch len(parts) {
+	case 1:
+		return resourceSelector{Kind: parts[0], Labels: labelSelector}, nil
+	case 2:
+		return resourceSelector{Kind: parts[0], Name: parts[1], Labels: labelSelector}, nil
+	case 3:
+		return resourceSelector{Kind: parts[0], Namespace: parts[1], Name: parts[2], Labels: labelSelector}, nil
+	default:
+		return resourceSelector{}, fmt.Errorf(
+			"invalid resource selector %q, expected Kind, Kind/name or Kind/namespace/name", selector)
+	}
+}
@@ -0,0 +1,339 @@
+package maestro
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
+)
+
+func TestParseCondition(t *testing.T) {
+	tests := []struct {
+		name     string
+		expr     string
+		expected string // canonical form of the compiled AST
+	}{
+		{
+			name:     "single ManifestWork condition",
+			expr:     "Available",
+			expected: "Available",
+		},
+		{
+			name:     "resource condition",
+			expr:     "Job/test-job-1:Complete",
+			expected: "Job/test-job-1:Complete",
+		},
+		{
+			name:     "comparison with spaces",
+			expr:     "Job/default/test-job:succeeded >= 1",
+			expected: "Job/default/test-job:succeeded>=1",
+		},
+		{
+			name:     "AND binds tighter than OR",
+			expr:     "A OR B AND C",
+			expected: "(A OR (B AND C))",
+		},
+		{
+			name:     "parentheses override precedence",
+			expr:     "(A OR B) AND C",
+			expected: "((A OR B) AND C)",
+		},
+		{
+			name:     "compact symbolic operators",
+			expr:     "A&&B||C",
+			expected: "((A AND B) OR C)",
+		},
+		{
+			name:     "NOT keyword",
+			expr:     "Available AND NOT Job:Failed",
+			expected: "(Available AND NOT Job:Failed)",
+		},
+		{
+			name:     "bang operator",
+			expr:     "!(A || B)",
+			expected: "NOT (A OR B)",
+		},
+		{
+			name:     "double quoted value with spaces",
+			expr:     `Job:status.message="job has completed"`,
+			expected: `Job:status.message="job has completed"`,
+		},
+		{
+			name:     "single quoted value with escaped quote",
+			expr:     `Job:reason='it\'s done'`,
+			expected: `Job:reason="it's done"`,
+		},
+		{
+			name:     "stability window",
+			expr:     "Available for 30s AND NOT Degraded FOR 1m",
+			expected: "(Available for 30s AND NOT Degraded for 1m)",
+		},
+		{
+			name:     "stability window of a group",
+			expr:     "(Available AND Job:Complete) for 2m30s",
+			expected: "(Available AND Job:Complete) for 2m30s",
+		},
+		{
+			name:     "quantifiers",
+			expr:     "all(Deployment):Available AND ANY(Job/default/*):Failed",
+			expected: "(all(Deployment):Available AND any(Job/default/*):Failed)",
+		},
+		{
+			name:     "count with comparison",
+			expr:     "count(Job:succeeded >= 1) >= 3",
+			expected: "count(Job:succeeded>=1)>=3",
+		},
+		{
+			name:     "glob and label selectors",
+			expr:     "all(Deployment/prod-*/*{app=web, tier in (a,b)}):Available",
+			expected: "all(Deployment/prod-*/*{app=web,tier in (a,b)}):Available",
+		},
+		{
+			name:     "JSONPath comparison",
+			expr:     `Pod:containerStatuses[?(@.name=="x")].ready!=false`,
+			expected: `Pod:containerStatuses[?(@.name=="x")].ready!=false`,
+		},
+		{
+			name:     "length and regular expression",
+			expr:     "Job:length(status.conditions) >= 1 AND Job:message =~ '^job (has )?completed$'",
+			expected: `(Job:length(status.conditions)>=1 AND Job:message=~"^job (has )?completed$")`,
+		},
+		{
+			name:     "ManifestWork condition status",
+			expr:     "Available=False AND Applied=true",
+			expected: "(Available=False AND Applied)",
+		},
+		{
+			name:     "quantifier keyword as condition name",
+			expr:     "all AND count",
+			expected: "(all AND count)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			condition, err := ParseCondition(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseCondition(%q) unexpected error: %v", tt.expr, err)
+			}
+			if got := condition.root.String(); got != tt.expected {
+				t.Errorf("ParseCondition(%q) = %s, expected %s", tt.expr, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseConditionErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		expr   string
+		column int
+	}{
+		{name: "empty expression", expr: "", column: 1},
+		{name: "misspelled operator", expr: "Job:Complete ANDD Available", column: 14},
+		{name: "dangling operator", expr: "Available AND", column: 14},
+		{name: "unbalanced parenthesis", expr: "(A OR B", column: 8},
+		{name: "unexpected closing parenthesis", expr: "A)", column: 2},
+		{name: "single ampersand", expr: "A & B", column: 3},
+		{name: "unterminated string", expr: `Job:phase="Active`, column: 11},
+		{name: "missing check after colon", expr: "Job: AND A", column: 6},
+		{name: "missing comparison value", expr: "Job:succeeded>=", column: 16},
+		{name: "comparison without selector", expr: "succeeded>=1", column: 10},
+		{name: "empty selector segment", expr: "Job//name:Complete", column: 1},
+		{name: "missing window duration", expr: "Available for", column: 14},
+		{name: "invalid window duration", expr: "Available for soon", column: 15},
+		{name: "zero window duration", expr: "Available for 0s OR Degraded", column: 15},
+		{name: "missing quantifier selector", expr: "all():Available", column: 5},
+		{name: "missing check after quantifier", expr: "all(Deployment) AND A", column: 17},
+		{name: "missing count comparison", expr: "count(Job:Complete)", column: 20},
+		{name: "invalid count", expr: "count(Job:Complete)>=some", column: 22},
+		{name: "unterminated label selector", expr: "Deployment{app=web:Available", column: 11},
+		{name: "invalid label selector", expr: "Deployment{=web}:Available", column: 1},
+		{name: "invalid glob pattern", expr: `Job/web\:Complete`, column: 1},
+		{name: "unterminated path index", expr: "Job:conditions[0.type=Ready", column: 15},
+		{name: "invalid path index", expr: "Job:conditions[first].type=Ready", column: 5},
+		{name: "length without comparison", expr: "Job:length(conditions)", column: 23},
+		{name: "invalid regular expression", expr: "Job:message=~'(unclosed'", column: 14},
+		{name: "regular expression in count", expr: "count(Job:Complete)=~1", column: 20},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCondition(tt.expr)
+			if err == nil {
+				t.Fatalf("ParseCondition(%q) expected error, got none", tt.expr)
+			}
+			var syntaxErr *ConditionSyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("ParseCondition(%q) error type = %T, expected *ConditionSyntaxError", tt.expr, err)
+			}
+			if syntaxErr.Column != tt.column {
+				t.Errorf("ParseCondition(%q) column = %d, expected %d (%v)", tt.expr, syntaxErr.Column, tt.column, err)
+			}
+		})
+	}
+}
+
+func TestConditionEvaluateStatusFeedback(t *testing.T) {
+	details := &ManifestWorkDetails{
+		ResourceStatus: []ResourceStatusInfo{
+			{
+				Kind:      "Job",
+				Name:      "test-job-1",
+				Namespace: "default",
+				StatusFeedback: map[string]interface{}{
+					"succeeded": int64(1),
+					"message":   "job has completed",
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Complete", "status": "True"},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		expr     string
+		expected bool
+	}{
+		{expr: "Job:Complete", expected: true},
+		{expr: "Job:Failed", expected: false},
+		{expr: "NOT Job:Failed", expected: true},
+		{expr: "Job/test-job-1:succeeded>=1", expected: true},
+		{expr: "Job/default/test-job-1:succeeded>1", expected: false},
+		{expr: "Job/other:Complete", expected: false},
+		{expr: `Job:message="job has completed"`, expected: true},
+		{expr: "Job:Failed OR Job:succeeded=1", expected: true},
+		{expr: "Job:succeeded!=1", expected: false},
+		{expr: "Job:missing!=1", expected: false},
+		{expr: "Job:message=~'^job .*completed$'", expected: true},
+		{expr: "Job:message=~failed", expected: false},
+		{expr: `Job:conditions[0].type=Complete`, expected: true},
+		{expr: `Job:conditions[?(@.type=="Complete")].status=True`, expected: true},
+		{expr: "Job:length(conditions)=1", expected: true},
+	}
+
+	log := logger.New(logger.Config{Level: "error", Format: "text"})
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			condition, err := ParseCondition(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseCondition(%q) unexpected error: %v", tt.expr, err)
+			}
+			if got := condition.Evaluate(context.Background(), details, log); got != tt.expected {
+				t.Errorf("Evaluate(%q) = %v, expected %v", tt.expr, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConditionQuantifiers(t *testing.T) {
+	available := func(kind, namespace, name, status string) ResourceStatusInfo {
+		return ResourceStatusInfo{
+			Kind:       kind,
+			Name:       name,
+			Namespace:  namespace,
+			Conditions: []ConditionSummary{{Type: "Available", Status: status}},
+		}
+	}
+	details := &ManifestWorkDetails{
+		Manifests: []ManifestInfo{
+			{Kind: "Deployment", Name: "web", Namespace: "prod-east", Labels: map[string]string{"app": "web"}},
+			{Kind: "Deployment", Name: "web", Namespace: "prod-west", Labels: map[string]string{"app": "web"}},
+			{Kind: "Deployment", Name: "cache", Namespace: "prod-east", Labels: map[string]string{"app": "cache"}},
+		},
+		ResourceStatus: []ResourceStatusInfo{
+			available("Deployment", "prod-east", "web", "True"),
+			available("Deployment", "prod-west", "web", "True"),
+			available("Deployment", "prod-east", "cache", "False"),
+			available("Job", "batch", "one", "True"),
+		},
+	}
+
+	tests := []struct {
+		expr     string
+		expected bool
+	}{
+		{expr: "Deployment:Available", expected: true},
+		{expr: "any(Deployment):Available", expected: true},
+		{expr: "all(Deployment):Available", expected: false},
+		{expr: "all(Deployment/*/web):Available", expected: true},
+		{expr: "all(Deployment{app=web}):Available", expected: true},
+		{expr: "all(Deployment{app!=web}):Available", expected: false},
+		{expr: "all(Deployment/prod-west/*):Available", expected: true},
+		{expr: "all(StatefulSet):Available", expected: false},
+		{expr: "any(*/batch/*):Available", expected: true},
+		{expr: "count(Deployment:Available)>=2", expected: true},
+		{expr: "count(Deployment:Available)>2", expected: false},
+		{expr: "count(Deployment/prod-east/*:Available)=1", expected: true},
+		{expr: "count(Deployment{app=cache}:Available)<1", expected: true},
+	}
+
+	log := logger.New(logger.Config{Level: "error", Format: "text"})
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			condition, err := ParseCondition(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseCondition(%q) unexpected error: %v", tt.expr, err)
+			}
+			if got := condition.Evaluate(context.Background(), details, log); got != tt.expected {
+				t.Errorf("Evaluate(%q) = %v, expected %v", tt.expr, got, tt.expected)
+			}
+			if trace := condition.Trace(context.Background(), details, log); trace.Result != tt.expected {
+				t.Errorf("Trace(%q).Result = %v, expected %v", tt.expr, trace.Result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConditionMatch(t *testing.T) {
+	details := &ManifestWorkDetails{
+		Conditions: []ConditionSummary{
+			{Type: "Applied", Status: "True"},
+			{Type: "Degraded", Status: "True"},
+		},
+		ResourceStatus: []ResourceStatusInfo{
+			{
+				Kind: "Job",
+				Name: "test-job-1",
+				StatusFeedback: map[string]interface{}{
+					"failed": int64(2),
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Failed", "status": "True"},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		expr    string
+		matched string // empty when the expression is false
+	}{
+		{expr: "Job:Failed", matched: "Job:Failed"},
+		{expr: "Job:Complete OR Job:Failed", matched: "Job:Failed"},
+		{expr: "Job:Failed OR Degraded", matched: "Job:Failed"},
+		{expr: "Progressing OR (Degraded AND Job:failed>=2)", matched: "(Degraded AND Job:failed>=2)"},
+		{expr: "NOT Available", matched: "NOT Available"},
+		{expr: "Job:Complete OR Progressing"},
+		{expr: "Degraded AND Job:Complete"},
+	}
+
+	log := logger.New(logger.Config{Level: "error", Format: "text"})
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			condition, err := ParseCondition(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseCondition(%q) unexpected error: %v", tt.expr, err)
+			}
+			matched, ok := condition.Match(context.Background(), details, log)
+			if ok != (tt.matched != "") || matched != tt.matched {
+				t.Errorf("Match(%q) = %q, %v, expected %q", tt.expr, matched, ok, tt.matched)
+			}
+			if ok != condition.Evaluate(context.Background(), details, log) {
+				t.Errorf("Match(%q) disagrees with Evaluate", tt.expr)
+			}
+		})
+	}
+}
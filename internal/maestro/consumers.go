@@ -0,0 +1,194 @@
+package maestro
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"k8s.io/apimachinery/pkg/labels"
+
+	clierrors "github.com/openshift-hyperfleet/maestro-cli/pkg/errors"
+)
+
+// ConsumerSummary represents a Maestro consumer (target cluster)
+type ConsumerSummary struct {
+	ID        string            `json:"id"`
+	Name      string            `json:"name"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	CreatedAt string            `json:"createdAt,omitempty"`
+	UpdatedAt string            `json:"updatedAt,omitempty"`
+}
+
+// ListConsumerSummariesHTTP lists all consumers with their labels using HTTP API, fetching every page
+func (c *Client) ListConsumerSummariesHTTP(ctx context.Context) ([]ConsumerSummary, error) {
+	pageSize := DefaultListPageSize
+	var summaries []ConsumerSummary
+
+	for page := int32(1); ; page++ {
+		consumerList, resp, err := c.httpClient.DefaultAPI.ApiMaestroV1ConsumersGet(ctx).
+			Page(page).
+			Size(pageSize).
+			Execute()
+		if err != nil {
+			return nil, newAPIError("failed to list consumers", resp, err)
+		}
+
+		for i := range consumerList.Items {
+			summaries = append(summaries, summaryFromConsumer(&consumerList.Items[i]))
+		}
+
+		// Stop on a short page or once every item reported by the server has been received
+		if int32(len(consumerList.Items)) < pageSize || //nolint:gosec // bounded by pageSize
+			int64(page)*int64(pageSize) >= int64(consumerList.Total) {
+			break
+		}
+	}
+
+	if summaries == nil {
+		summaries = []ConsumerSummary{}
+	}
+	return summaries, nil
+}
+
+// SelectConsumers returns the sorted names of the consumers whose labels match the selector
+// The selector uses Kubernetes label selector syntax (e.g. "env=prod,region in (us-east,us-west)");
+// an empty selector matches every consumer.
+func (c *Client) SelectConsumers(ctx context.Context, selector string) ([]string, error) {
+	parsed, err := labels.Parse(selector)
+	if err != nil {
+		return nil, clierrors.NewValidationFailed("invalid consumer selector %q: %w", selector, err)
+	}
+
+	consumers, err := c.ListConsumerSummariesHTTP(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(consumers))
+	for _, consumer := range consumers {
+		if parsed.Matches(labels.Set(consumer.Labels)) {
+			names = append(names, consumer.Name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// summaryFromConsumer converts an API consumer into a ConsumerSummary
+func summaryFromConsumer(consumer *openapi.Consumer) ConsumerSummary {
+	summary := ConsumerSummary{
+		ID:   getStringPtr(consumer.Id),
+		Name: getStringPtr(consumer.Name),
+	}
+	if consumer.Labels != nil {
+		summary.Labels = *consumer.Labels
+	}
+	if consumer.CreatedAt != nil {
+		summary.CreatedAt = consumer.CreatedAt.Format(time.RFC3339)
+	}
+	if consumer.UpdatedAt != nil {
+		summary.UpdatedAt = consumer.UpdatedAt.Format(time.RFC3339)
+	}
+	return summary
+}
+
+// GetConsumerHTTP looks up a consumer by name using HTTP API
+func (c *Client) GetConsumerHTTP(ctx context.Context, name string) (*ConsumerSummary, error) {
+	if err := validateSearchQuery(name); err != nil {
+		return nil, clierrors.NewValidationFailed("invalid consumer name: %w", err)
+	}
+
+	consumerList, resp, err := c.httpClient.DefaultAPI.ApiMaestroV1ConsumersGet(ctx).
+		Search(fmt.Sprintf("name = '%s'", name)).
+		Execute()
+	if err != nil {
+		return nil, newAPIError("failed to search consumers", resp, err)
+	}
+
+	for i := range consumerList.Items {
+		if consumerList.Items[i].Name != nil && *consumerList.Items[i].Name == name {
+			summary := summaryFromConsumer(&consumerList.Items[i])
+			return &summary, nil
+		}
+	}
+	return nil, clierrors.NewNotFound("consumer %q not found", name)
+}
+
+// CreateConsumerHTTP registers a new consumer with the given labels using HTTP API
+func (c *Client) CreateConsumerHTTP(
+	ctx context.Context,
+	name string,
+	labels map[string]string,
+) (*ConsumerSummary, error) {
+	consumer := openapi.Consumer{Name: &name}
+	if len(labels) > 0 {
+		consumer.Labels = &labels
+	}
+
+	created, resp, err := c.httpClient.DefaultAPI.ApiMaestroV1ConsumersPost(ctx).Consumer(consumer).Execute()
+	if err != nil {
+		return nil, newAPIError("failed to create consumer", resp, err)
+	}
+
+	summary := summaryFromConsumer(created)
+	return &summary, nil
+}
+
+// SetConsumerLabelsHTTP replaces the labels of a consumer using HTTP API
+func (c *Client) SetConsumerLabelsHTTP(
+	ctx context.Context,
+	name string,
+	labels map[string]string,
+) (*ConsumerSummary, error) {
+	consumer, err := c.GetConsumerHTTP(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	updated, resp, err := c.httpClient.DefaultAPI.ApiMaestroV1ConsumersIdPatch(ctx, consumer.ID).
+		ConsumerPatchRequest(openapi.ConsumerPatchRequest{Labels: &labels}).
+		Execute()
+	if err != nil {
+		return nil, newAPIError("failed to update consumer labels", resp, err)
+	}
+
+	summary := summaryFromConsumer(updated)
+	return &summary, nil
+}
+
+// DeleteConsumerHTTP deletes a consumer using HTTP API
+func (c *Client) DeleteConsumerHTTP(ctx context.Context, name string) error {
+	consumer, err := c.GetConsumerHTTP(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.DefaultAPI.ApiMaestroV1ConsumersIdDelete(ctx, consumer.ID).Execute()
+	if err != nil {
+		return newAPIError("failed to delete consumer", resp, err)
+	}
+	return nil
+}
+
+// CountManifestWorksHTTP returns the number of ManifestWorks of a consumer using HTTP API
+// Only the total of a one-item page is requested, so no ManifestWork content is transferred.
+func (c *Client) CountManifestWorksHTTP(ctx context.Context, consumer string) (int, error) {
+	if err := validateSearchQuery(consumer); err != nil {
+		return 0, fmt.Errorf("invalid consumer name: %w", err)
+	}
+
+	resourceList, resp, err := c.httpClient.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).
+		Search(fmt.Sprintf("consumer_name = '%s'", consumer)).
+		Page(1).
+		Size(1).
+		Execute()
+	if err != nil {
+		return 0, newAPIError("failed to count resource bundles", resp, err)
+	}
+	return int(resourceList.Total), nil
+}
@@ -0,0 +1,148 @@
+package maestro
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+
+	clierrors "github.com/openshift-hyperfleet/maestro-cli/pkg/errors"
+)
+
+func TestSelectConsumers(t *testing.T) {
+	consumers := []map[string]interface{}{
+		{"id": "1", "name": "prod-east", "labels": map[string]string{"env": "prod", "region": "east"}},
+		{"id": "2", "name": "prod-west", "labels": map[string]string{"env": "prod", "region": "west"}},
+		{"id": "3", "name": "staging", "labels": map[string]string{"env": "staging"}},
+		{"id": "4", "name": "unlabelled"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		size, _ := strconv.Atoi(r.URL.Query().Get("size"))
+		items := []interface{}{}
+		for i := (page - 1) * size; i >= 0 && i < page*size && i < len(consumers); i++ {
+			items = append(items, consumers[i])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"kind": "ConsumerList", "page": page, "size": len(items), "total": len(consumers), "items": items,
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewHTTPClient(ClientConfig{HTTPEndpoint: server.URL})
+	if err != nil {
+		t.Fatalf("NewHTTPClient() unexpected error: %v", err)
+	}
+	tests := []struct {
+		selector string
+		expected []string
+	}{
+		{selector: "", expected: []string{"prod-east", "prod-west", "staging", "unlabelled"}},
+		{selector: "env=prod", expected: []string{"prod-east", "prod-west"}},
+		{selector: "env in (prod,staging),region!=west", expected: []string{"prod-east", "staging"}},
+		{selector: "!env", expected: []string{"unlabelled"}},
+		{selector: "env=dev", expected: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			names, err := client.SelectConsumers(context.Background(), tt.selector)
+			if err != nil {
+				t.Fatalf("SelectConsumers() unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(names, tt.expected) {
+				t.Errorf("SelectConsumers(%q) = %v, expected %v", tt.selector, names, tt.expected)
+			}
+		})
+	}
+
+	if _, err := client.SelectConsumers(context.Background(), "env in (prod"); err == nil {
+		t.Errorf("SelectConsumers() expected error for invalid selector")
+	}
+}
+
+func TestConsumerLifecycleHTTP(t *testing.T) {
+	consumer := map[string]interface{}{"id": "c1", "name": "cluster1", "labels": map[string]string{"env": "prod"}}
+	var patched map[string]interface{}
+	deleted := false
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/maestro/v1/consumers":
+			items := []interface{}{}
+			if r.URL.Query().Get("search") == "name = 'cluster1'" {
+				items = append(items, consumer)
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"kind": "ConsumerList", "page": 1, "size": len(items), "total": len(items), "items": items,
+			})
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/maestro/v1/consumers/c1":
+			_ = json.NewDecoder(r.Body).Decode(&patched)
+			updated := map[string]interface{}{"id": "c1", "name": "cluster1", "labels": patched["labels"]}
+			_ = json.NewEncoder(w).Encode(updated)
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/maestro/v1/consumers/c1":
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/maestro/v1/resource-bundles":
+			if r.URL.Query().Get("search") != "consumer_name = 'cluster1'" || r.URL.Query().Get("size") != "1" {
+				t.Errorf("unexpected resource bundle query %q", r.URL.RawQuery)
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"kind": "ResourceBundleList", "page": 1, "size": 0, "total": 3, "items": []interface{}{},
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewHTTPClient(ClientConfig{HTTPEndpoint: server.URL})
+	if err != nil {
+		t.Fatalf("NewHTTPClient() unexpected error: %v", err)
+	}
+	ctx := context.Background()
+
+	got, err := client.GetConsumerHTTP(ctx, "cluster1")
+	if err != nil {
+		t.Fatalf("GetConsumerHTTP() unexpected error: %v", err)
+	}
+	if got.ID != "c1" || got.Labels["env"] != "prod" {
+		t.Errorf("GetConsumerHTTP() = %+v, expected id c1 with env=prod", got)
+	}
+
+	_, err = client.GetConsumerHTTP(ctx, "missing")
+	if clierrors.FromError(err).Type != clierrors.TypeNotFound {
+		t.Errorf("GetConsumerHTTP(missing) error = %v, expected NotFound", err)
+	}
+
+	updated, err := client.SetConsumerLabelsHTTP(ctx, "cluster1", map[string]string{"env": "staging"})
+	if err != nil {
+		t.Fatalf("SetConsumerLabelsHTTP() unexpected error: %v", err)
+	}
+	expectedLabels := map[string]interface{}{"env": "staging"}
+	if !reflect.DeepEqual(patched["labels"], expectedLabels) || updated.Labels["env"] != "staging" {
+		t.Errorf("SetConsumerLabelsHTTP() sent %v, returned %v", patched["labels"], updated.Labels)
+	}
+
+	count, err := client.CountManifestWorksHTTP(ctx, "cluster1")
+	if err != nil {
+		t.Fatalf("CountManifestWorksHTTP() unexpected error: %v", err)
+	}
+	if count != 3 {
+		t.Errorf("CountManifestWorksHTTP() = %d, expected 3", count)
+	}
+
+	if err := client.DeleteConsumerHTTP(ctx, "cluster1"); err != nil {
+		t.Fatalf("DeleteConsumerHTTP() unexpected error: %v", err)
+	}
+	if !deleted {
+		t.Errorf("DeleteConsumerHTTP() did not delete the consumer")
+	}
+}
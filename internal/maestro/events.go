@@ -0,0 +1,296 @@
+package maestro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	workv1client "open-cluster-management.io/api/client/work/clientset/versioned/typed/work/v1"
+	workv1 "open-cluster-management.io/api/work/v1"
+
+	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
+)
+
+const (
+	// DefaultResyncInterval is the HTTP polling interval used as a resync while
+	// status updates are being received over the gRPC CloudEvents subscription
+	DefaultResyncInterval = 30 * time.Second
+
+	// conditionDeleted is the condition set on a work by the source client when it is deleted
+	conditionDeleted = "Deleted"
+
+	// workWatchDrainPeriod is how long a stopped watch is drained before it is dropped
+	workWatchDrainPeriod = 2 * time.Second
+)
+
+// WorkEvent is a ManifestWork status update received over the gRPC CloudEvents subscription
+type WorkEvent struct {
+	Deleted bool
+	Details *ManifestWorkDetails
+}
+
+// SubscribeWorkStatus subscribes to status updates of a single ManifestWork over gRPC.
+// It returns nil when the client has no gRPC connection or the watch cannot be established,
+// in which case callers should rely on HTTP polling. Only the latest undelivered event is kept,
+// so slow consumers never block the subscription. The channel is closed when ctx ends or the
+// watch ends.
+//
+// The subscriptions of a consumer share one watch, see workWatches.
+// Note: Maestro only delivers status updates for works created with this client's source ID.
+func (c *Client) SubscribeWorkStatus(
+	ctx context.Context,
+	consumer, workName string,
+	log *logger.Logger,
+) <-chan WorkEvent {
+	if c.workClient == nil {
+		return nil
+	}
+
+	sub := &workSubscriber{name: workName, events: make(chan WorkEvent, 1)}
+	started, err := c.watches.subscribe(consumer, sub, func() (watch.Interface, error) {
+		var watcher watch.Interface
+		err := c.manifestWorks(consumer, func(works workv1client.ManifestWorkInterface) error {
+			var watchErr error
+			// The watch outlives this subscription, it is stopped once its last subscription ends
+			watcher, watchErr = works.Watch(context.WithoutCancel(ctx), metav1.ListOptions{})
+			return watchErr
+		})
+		return watcher, err
+	})
+	if err != nil {
+		log.Warn(ctx, "Failed to subscribe to status updates, falling back to HTTP polling", logger.Fields{
+			"name":     workName,
+			"consumer": consumer,
+			"error":    err.Error(),
+		})
+		return nil
+	}
+	if started != nil {
+		go c.watches.dispatch(consumer, started, log)
+	}
+
+	go func() {
+		<-ctx.Done()
+		c.watches.unsubscribe(consumer, sub)
+	}()
+	return sub.events
+}
+
+// workSubscriber receives the status updates of one ManifestWork
+type workSubscriber struct {
+	name   string
+	events chan WorkEvent
+}
+
+// workWatch is the watch of a consumer shared by the subscriptions to its ManifestWorks
+type workWatch struct {
+	watcher     watch.Interface
+	subscribers map[*workSubscriber]struct{}
+	stopped     chan struct{} // closed once the last subscription ended
+}
+
+// workWatches holds the watch of each consumer with active subscriptions.
+// The gRPC source client keeps one watcher per consumer and blocks while delivering an event
+// to it, holding its store lock, until the event is read. So every subscription to a consumer
+// shares one watch whose events are fanned out by ManifestWork name, and the watch is drained
+// for a while after it is stopped so a delivery in flight cannot block the source client.
+type workWatches struct {
+	mu      sync.Mutex
+	watches map[string]*workWatch
+}
+
+// subscribe adds the subscriber to the watch of the consumer, starting the watch if there is none
+// The watch is returned when it was started by this call, so the caller dispatches its events.
+func (w *workWatches) subscribe(
+	consumer string,
+	sub *workSubscriber,
+	start func() (watch.Interface, error),
+) (*workWatch, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if ww, ok := w.watches[consumer]; ok {
+		ww.subscribers[sub] = struct{}{}
+		return nil, nil
+	}
+	watcher, err := start()
+	if err != nil {
+		return nil, err
+	}
+	ww := &workWatch{
+		watcher:     watcher,
+		subscribers: map[*workSubscriber]struct{}{sub: {}},
+		stopped:     make(chan struct{}),
+	}
+	if w.watches == nil {
+		w.watches = make(map[string]*workWatch)
+	}
+	w.watches[consumer] = ww
+	return ww, nil
+}
+
+// unsubscribe closes the channel of the subscriber and stops the watch after its last subscription
+func (w *workWatches) unsubscribe(consumer string, sub *workSubscriber) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	ww, ok := w.watches[consumer]
+	if !ok {
+		return
+	}
+	if _, ok := ww.subscribers[sub]; !ok {
+		return
+	}
+	delete(ww.subscribers, sub)
+	if len(ww.subscribers) == 0 {
+		delete(w.watches, consumer)
+		close(ww.stopped)
+		ww.watcher.Stop()
+	}
+	close(sub.events)
+}
+
+// dispatch delivers the events of a watch to the subscribers of their ManifestWork until the
+// watch is stopped or ends; the subscriptions of a watch that ends are closed
+func (w *workWatches) dispatch(consumer string, ww *workWatch, log *logger.Logger) {
+	ctx := context.Background()
+	for {
+		select {
+		case <-ww.stopped:
+			drainWatch(ww.watcher, workWatchDrainPeriod)
+			return
+		case evt, ok := <-ww.watcher.ResultChan():
+			if !ok {
+				w.end(consumer, ww)
+				return
+			}
+			work, ok := evt.Object.(*workv1.ManifestWork)
+			if !ok {
+				continue
+			}
+
+			workEvent := WorkEvent{
+				Deleted: evt.Type == watch.Deleted ||
+					meta.IsStatusConditionTrue(work.Status.Conditions, conditionDeleted),
+			}
+			details, err := detailsFromManifestWork(consumer, work)
+			if err != nil {
+				log.Warn(ctx, "Failed to convert status update", logger.Fields{
+					"name":  work.Name,
+					"error": err.Error(),
+				})
+				continue
+			}
+			workEvent.Details = details
+
+			log.Debug(ctx, "Received status update", logger.Fields{
+				"name":    work.Name,
+				"type":    string(evt.Type),
+				"version": details.Version,
+			})
+			w.publish(ww, work.Name, workEvent)
+		}
+	}
+}
+
+// publish hands the event to the subscribers of the ManifestWork
+func (w *workWatches) publish(ww *workWatch, name string, workEvent WorkEvent) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for sub := range ww.subscribers {
+		if sub.name != name {
+			continue
+		}
+		// Keep only the latest event: drop a pending one the subscriber hasn't read yet
+		select {
+		case sub.events <- workEvent:
+		default:
+			select {
+			case <-sub.events:
+			default:
+			}
+			sub.events <- workEvent
+		}
+	}
+}
+
+// end closes the subscriptions of a watch that ended on its own
+func (w *workWatches) end(consumer string, ww *workWatch) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for sub := range ww.subscribers {
+		close(sub.events)
+	}
+	ww.subscribers = nil
+	if w.watches[consumer] == ww {
+		delete(w.watches, consumer)
+	}
+}
+
+// drainWatch reads the events of a stopped watcher until its channel is closed or the period passed
+func drainWatch(watcher watch.Interface, period time.Duration) {
+	timeout := time.NewTimer(period)
+	defer timeout.Stop()
+	for {
+		select {
+		case _, ok := <-watcher.ResultChan():
+			if !ok {
+				return
+			}
+		case <-timeout.C:
+			return
+		}
+	}
+}
+
+// detailsFromManifestWork converts a ManifestWork received over gRPC into ManifestWorkDetails.
+// The status is converted through the same resource bundle representation used by the HTTP API,
+// so conditions evaluate identically regardless of where the status came from.
+func detailsFromManifestWork(consumer string, work *workv1.ManifestWork) (*ManifestWorkDetails, error) {
+	details := &ManifestWorkDetails{
+		ID:           string(work.UID),
+		Name:         work.Name,
+		ConsumerName: consumer,
+		Labels:       work.Labels,
+		Version:      int32(work.Generation), //nolint:gosec // Generation is the resource bundle version
+		Generation:   work.Generation,
+	}
+	if !work.CreationTimestamp.IsZero() {
+		details.CreatedAt = work.CreationTimestamp.Format(time.RFC3339)
+	}
+	if work.Spec.DeleteOption != nil {
+		details.DeleteOption = string(work.Spec.DeleteOption.PropagationPolicy)
+	}
+
+	manifests := make([]map[string]interface{}, 0, len(work.Spec.Workload.Manifests))
+	for i, m := range work.Spec.Workload.Manifests {
+		var obj map[string]interface{}
+		if err := json.Unmarshal(m.Raw, &obj); err != nil {
+			return nil, fmt.Errorf("failed to decode manifest %d: %w", i, err)
+		}
+		manifests = append(manifests, obj)
+	}
+	details.Manifests = manifestInfosFromBundle(manifests)
+
+	data, err := json.Marshal(map[string]interface{}{
+		"conditions":     work.Status.Conditions,
+		"resourceStatus": work.Status.ResourceStatus.Manifests,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode status: %w", err)
+	}
+	var status map[string]interface{}
+	if err := json.Unmarshal(data, &status); err != nil {
+		return nil, fmt.Errorf("failed to decode status: %w", err)
+	}
+	applyBundleStatus(details, status)
+
+	return details, nil
+}
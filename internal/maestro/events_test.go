@@ -0,0 +1,187 @@
+package maestro
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	clienttesting "k8s.io/client-go/testing"
+	workfake "open-cluster-management.io/api/client/work/clientset/versioned/fake"
+	workv1 "open-cluster-management.io/api/work/v1"
+
+	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
+)
+
+func TestDetailsFromManifestWork(t *testing.T) {
+	succeeded := int64(1)
+	work := &workv1.ManifestWork{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-mw",
+			UID:        "3f1c2a9e-0000-0000-0000-000000000001",
+			Generation: 2,
+		},
+		Spec: workv1.ManifestWorkSpec{
+			Workload: workv1.ManifestsTemplate{
+				Manifests: []workv1.Manifest{
+					{RawExtension: runtime.RawExtension{
+						Raw: []byte(`{"apiVersion":"batch/v1","kind":"Job",` +
+							`"metadata":{"name":"test-job-1","labels":{"app":"batch"}}}`),
+					}},
+				},
+			},
+		},
+		Status: workv1.ManifestWorkStatus{
+			Conditions: []metav1.Condition{
+				{Type: "Applied", Status: metav1.ConditionTrue, Reason: "AppliedManifestWorkComplete"},
+			},
+			ResourceStatus: workv1.ManifestResourceStatus{
+				Manifests: []workv1.ManifestCondition{
+					{
+						ResourceMeta: workv1.ManifestResourceMeta{Kind: "Job", Name: "test-job-1", Namespace: "default"},
+						StatusFeedbacks: workv1.StatusFeedbackResult{
+							Values: []workv1.FeedbackValue{
+								{
+									Name: "succeeded",
+									Value: workv1.FieldValue{
+										Type:    workv1.Integer,
+										Integer: &succeeded,
+									},
+								},
+							},
+						},
+						Conditions: []metav1.Condition{
+							{Type: "Available", Status: metav1.ConditionTrue},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	details, err := detailsFromManifestWork("agent1", work)
+	if err != nil {
+		t.Fatalf("detailsFromManifestWork() unexpected error: %v", err)
+	}
+
+	if details.Name != "test-mw" || details.ConsumerName != "agent1" || details.Version != 2 {
+		t.Errorf("unexpected metadata: name=%s consumer=%s version=%d",
+			details.Name, details.ConsumerName, details.Version)
+	}
+	if len(details.Manifests) != 1 || details.Manifests[0].Kind != "Job" || details.Manifests[0].Labels["app"] != "batch" {
+		t.Errorf("expected 1 Job manifest labeled app=batch, got %+v", details.Manifests)
+	}
+	if len(details.Conditions) != 1 || details.Conditions[0].Type != "Applied" {
+		t.Errorf("expected Applied condition, got %+v", details.Conditions)
+	}
+
+	// Status converted from gRPC must evaluate the same as status fetched over HTTP
+	condition, err := ParseCondition("Applied AND Job/default/test-job-1{app=batch}:succeeded>=1")
+	if err != nil {
+		t.Fatalf("ParseCondition() unexpected error: %v", err)
+	}
+	log := logger.New(logger.Config{Level: "error", Format: "text"})
+	if !condition.Evaluate(context.Background(), details, log) {
+		t.Errorf("expected condition %s to be met, details: %+v", condition, details)
+	}
+}
+
+// sourceWatcher behaves like the watcher of the gRPC source client: Stop does not close its
+// result channel, and a delivery blocks until the event is read
+type sourceWatcher struct {
+	result  chan watch.Event
+	stopped atomic.Bool
+}
+
+func (w *sourceWatcher) ResultChan() <-chan watch.Event { return w.result }
+
+func (w *sourceWatcher) Stop() { w.stopped.Store(true) }
+
+// receiveEvent returns the next event of a subscription, or fails the test if none arrives
+func receiveEvent(t *testing.T, events <-chan WorkEvent) (WorkEvent, bool) {
+	t.Helper()
+	select {
+	case evt, ok := <-events:
+		return evt, ok
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for a status update")
+		return WorkEvent{}, false
+	}
+}
+
+func TestSubscribeWorkStatusSharesWatch(t *testing.T) {
+	log := logger.New(logger.Config{Level: "error", Format: "text"})
+	fakeClient := workfake.NewSimpleClientset()
+	client := &Client{workClient: fakeClient.WorkV1()}
+	works := fakeClient.WorkV1().ManifestWorks("agent1")
+
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	defer cancel2()
+	api := client.SubscribeWorkStatus(ctx1, "agent1", "api", log)
+	db := client.SubscribeWorkStatus(ctx2, "agent1", "db", log)
+
+	for _, name := range []string{"api", "db"} {
+		if _, err := works.Create(context.Background(), testWork(name, "v1"), metav1.CreateOptions{}); err != nil {
+			t.Fatalf("failed to create work %s: %v", name, err)
+		}
+	}
+	if evt, _ := receiveEvent(t, api); evt.Details == nil || evt.Details.Name != "api" {
+		t.Errorf("expected an update of api, got %+v", evt.Details)
+	}
+	if evt, _ := receiveEvent(t, db); evt.Details == nil || evt.Details.Name != "db" {
+		t.Errorf("expected an update of db, got %+v", evt.Details)
+	}
+
+	// Ending one subscription closes its channel and keeps the shared watch for the other
+	cancel1()
+	if _, ok := receiveEvent(t, api); ok {
+		t.Error("expected the channel of the ended subscription to be closed")
+	}
+	if err := works.Delete(context.Background(), "db", metav1.DeleteOptions{}); err != nil {
+		t.Fatalf("failed to delete work: %v", err)
+	}
+	if evt, _ := receiveEvent(t, db); !evt.Deleted {
+		t.Errorf("expected a deletion of db, got %+v", evt)
+	}
+
+	watches := 0
+	for _, action := range fakeClient.Actions() {
+		if action.GetVerb() == "watch" {
+			watches++
+		}
+	}
+	if watches != 1 {
+		t.Errorf("expected the subscriptions to share 1 watch, got %d", watches)
+	}
+}
+
+func TestSubscribeWorkStatusDrainsStoppedWatch(t *testing.T) {
+	log := logger.New(logger.Config{Level: "error", Format: "text"})
+	watcher := &sourceWatcher{result: make(chan watch.Event)}
+	fakeClient := workfake.NewSimpleClientset()
+	fakeClient.PrependWatchReactor("manifestworks", func(clienttesting.Action) (bool, watch.Interface, error) {
+		return true, watcher, nil
+	})
+	client := &Client{workClient: fakeClient.WorkV1()}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := client.SubscribeWorkStatus(ctx, "agent1", "api", log)
+	cancel()
+	if _, ok := receiveEvent(t, events); ok {
+		t.Fatal("expected the channel of the ended subscription to be closed")
+	}
+	if !watcher.stopped.Load() {
+		t.Fatal("expected the watch to be stopped after its last subscription ended")
+	}
+
+	// A delivery in flight when the watch was stopped must not block the source client
+	select {
+	case watcher.result <- watch.Event{Type: watch.Modified, Object: testWork("api", "v2")}:
+	case <-time.After(time.Second):
+		t.Error("the event sent to the stopped watch was never read")
+	}
+}
@@ -0,0 +1,321 @@
+package maestro
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// fieldPath is a compiled status feedback path of a comparison, a subset of JSONPath:
+//
+//	status.succeeded                          nested fields, optionally starting with $ or .
+//	status.conditions[0].type                 list index, negative from the end
+//	containerStatuses[*].ready                every element of a list or value of a map
+//	containerStatuses[?(@.name=="x")].ready   list elements matching a filter
+//	length(status.conditions)                 number of elements of a list, map or string
+//
+// A path with a wildcard or filter selects any number of values; a comparison holds when any
+// of them satisfies it, or for != when none of them is equal. length() of such a path is the
+// number of values selected.
+type fieldPath struct {
+	text     string
+	segments []pathSegment
+	multi    bool // whether the path has a wildcard or filter
+	length   bool
+}
+
+// pathSegment selects values from a value selected by the previous segments
+type pathSegment interface {
+	apply(value interface{}) []interface{}
+}
+
+// pathMatches are the values selected by a path with a wildcard or filter
+type pathMatches []interface{}
+
+// parseFieldPath compiles a comparison path such as status.conditions[?(@.type=="Ready")].status
+func parseFieldPath(text string) (*fieldPath, error) {
+	path := &fieldPath{text: text}
+	expr := text
+	if strings.HasPrefix(expr, "length(") {
+		if !strings.HasSuffix(expr, ")") {
+			return nil, fmt.Errorf("invalid path %q: expected ')' to close 'length('", text)
+		}
+		path.length = true
+		expr = expr[len("length(") : len(expr)-1]
+	}
+	if expr == "" {
+		return nil, fmt.Errorf("invalid path %q: empty path", text)
+	}
+
+	segments, multi, err := parsePathSegments(strings.TrimPrefix(expr, "$"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid path %q: %w", text, err)
+	}
+	path.segments = segments
+	path.multi = multi
+	return path, nil
+}
+
+// parsePathSegments parses dot-separated fields and bracketed indices, wildcards and filters
+func parsePathSegments(expr string) ([]pathSegment, bool, error) {
+	var segments []pathSegment
+	multi := false
+	for i := 0; i < len(expr); {
+		if expr[i] == '[' {
+			end := closingBracket(expr, i)
+			if end < 0 {
+				return nil, false, fmt.Errorf("unterminated '[' at %q", expr[i:])
+			}
+			segment, selectsMany, err := parseBracket(expr[i+1 : end])
+			if err != nil {
+				return nil, false, err
+			}
+			segments = append(segments, segment)
+			multi = multi || selectsMany
+			i = end + 1
+			continue
+		}
+
+		if expr[i] == '.' {
+			i++
+		} else if i > 0 {
+			return nil, false, fmt.Errorf("expected '.' or '[' at %q", expr[i:])
+		}
+		start := i
+		for i < len(expr) && expr[i] != '.' && expr[i] != '[' {
+			i++
+		}
+		switch name := expr[start:i]; name {
+		case "":
+			return nil, false, fmt.Errorf("expected field name at %q", expr[start:])
+		case "*":
+			segments = append(segments, wildcardSegment{})
+			multi = true
+		default:
+			segments = append(segments, fieldSegment(name))
+		}
+	}
+	return segments, multi, nil
+}
+
+// closingBracket returns the index of the ']' closing the '[' at expr[start], or -1
+// Nested brackets and quoted strings, which may contain brackets, are skipped.
+func closingBracket(expr string, start int) int {
+	depth := 0
+	var quote byte
+	for i := start; i < len(expr); i++ {
+		switch ch := expr[i]; {
+		case quote != 0:
+			if ch == '\\' {
+				i++
+			} else if ch == quote {
+				quote = 0
+			}
+		case ch == '"' || ch == '\'':
+			quote = ch
+		case ch == '[':
+			depth++
+		case ch == ']':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// parseBracket parses the content of [...]: *, an index, a quoted field name or a ?(filter)
+func parseBracket(content string) (pathSegment, bool, error) {
+	content = strings.TrimSpace(content)
+	switch {
+	case content == "*":
+		return wildcardSegment{}, true, nil
+	case strings.HasPrefix(content, "?(") && strings.HasSuffix(content, ")"):
+		filter, err := parsePathFilter(strings.TrimSpace(content[2 : len(content)-1]))
+		if err != nil {
+			return nil, false, err
+		}
+		return filter, true, nil
+	case len(content) >= 2 && (content[0] == '"' || content[0] == '\'') && content[len(content)-1] == content[0]:
+		return fieldSegment(unquotePathLiteral(content)), false, nil
+	}
+	index, err := strconv.Atoi(content)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid index [%s], expected a number, *, a quoted field or ?(filter)", content)
+	}
+	return indexSegment(index), false, nil
+}
+
+// filterOperators are the comparisons of filters, longest first so >= is not read as >
+var filterOperators = []string{"==", "!=", ">=", "<=", "=~", ">", "<"}
+
+// parsePathFilter parses @.path, which keeps elements having the field, or @.path OP literal
+func parsePathFilter(expr string) (pathSegment, error) {
+	if !strings.HasPrefix(expr, "@") {
+		return nil, fmt.Errorf("invalid filter ?(%s), expected @ followed by a path", expr)
+	}
+
+	left, operator, value := expr[1:], "", ""
+	for i := 1; i < len(expr) && operator == ""; i++ {
+		switch expr[i] {
+		case '[':
+			if end := closingBracket(expr, i); end > 0 {
+				i = end
+			}
+			continue
+		case '"', '\'':
+			return nil, fmt.Errorf("invalid filter ?(%s), expected a comparison before %q", expr, expr[i:])
+		}
+		for _, op := range filterOperators {
+			if strings.HasPrefix(expr[i:], op) {
+				left, operator = expr[1:i], op
+				value = unquotePathLiteral(strings.TrimSpace(expr[i+len(op):]))
+				break
+			}
+		}
+	}
+
+	segments, multi, err := parsePathSegments(strings.TrimSpace(left))
+	if err != nil {
+		return nil, err
+	}
+	if operator == "==" {
+		operator = "="
+	}
+	if operator == "=~" {
+		if _, err := regexp.Compile(value); err != nil {
+			return nil, fmt.Errorf("invalid regular expression %q in filter: %w", value, err)
+		}
+	}
+	return filterSegment{path: fieldPath{segments: segments, multi: multi}, operator: operator, value: value}, nil
+}
+
+// unquotePathLiteral removes the quotes around a single or double quoted literal
+func unquotePathLiteral(literal string) string {
+	if len(literal) < 2 || (literal[0] != '"' && literal[0] != '\'') || literal[len(literal)-1] != literal[0] {
+		return literal
+	}
+	value, _, ok := scanQuoted(literal, 0)
+	if !ok {
+		return literal
+	}
+	return value
+}
+
+// lookup returns the value the path selects in data, or its length for length(path)
+// found is false when the path selects nothing; a path with a wildcard or filter returns pathMatches.
+func (p *fieldPath) lookup(data interface{}) (value interface{}, found bool) {
+	values := []interface{}{data}
+	for _, segment := range p.segments {
+		var next []interface{}
+		for _, v := range values {
+			next = append(next, segment.apply(v)...)
+		}
+		values = next
+	}
+
+	switch {
+	case p.length && p.multi:
+		return len(values), true
+	case len(values) == 0:
+		return nil, false
+	case p.length:
+		switch v := values[0].(type) {
+		case []interface{}:
+			return len(v), true
+		case map[string]interface{}:
+			return len(v), true
+		case string:
+			return len(v), true
+		default:
+			return nil, false
+		}
+	case p.multi:
+		return pathMatches(values), true
+	default:
+		return values[0], true
+	}
+}
+
+// String returns the path as written in the expression
+func (p *fieldPath) String() string {
+	return p.text
+}
+
+// fieldSegment selects a field of a map
+type fieldSegment string
+
+func (s fieldSegment) apply(value interface{}) []interface{} {
+	if m, ok := value.(map[string]interface{}); ok {
+		if v, ok := m[string(s)]; ok && v != nil {
+			return []interface{}{v}
+		}
+	}
+	return nil
+}
+
+// indexSegment selects an element of a list, counting from the end when negative
+type indexSegment int
+
+func (s indexSegment) apply(value interface{}) []interface{} {
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil
+	}
+	index := int(s)
+	if index < 0 {
+		index += len(list)
+	}
+	if index < 0 || index >= len(list) {
+		return nil
+	}
+	return []interface{}{list[index]}
+}
+
+// wildcardSegment selects every element of a list or value of a map, in key order
+type wildcardSegment struct{}
+
+func (wildcardSegment) apply(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		return v
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		values := make([]interface{}, 0, len(v))
+		for _, k := range keys {
+			values = append(values, v[k])
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+// filterSegment selects the elements of a list for which path is found, or compares to value
+type filterSegment struct {
+	path     fieldPath
+	operator string // empty for an existence filter
+	value    string
+}
+
+func (s filterSegment) apply(value interface{}) []interface{} {
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil
+	}
+	var selected []interface{}
+	for _, element := range list {
+		actual, found := s.path.lookup(element)
+		if (s.operator == "" && found) || (s.operator != "" && evaluateComparison(actual, s.operator, s.value)) {
+			selected = append(selected, element)
+		}
+	}
+	return selected
+}
@@ -0,0 +1,120 @@
+package maestro
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFieldPathLookup(t *testing.T) {
+	feedback := map[string]interface{}{
+		"status": map[string]interface{}{
+			"succeeded": int64(2),
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "True"},
+				map[string]interface{}{"type": "Progressing", "status": "False"},
+			},
+		},
+		"containerStatuses": []interface{}{
+			map[string]interface{}{"name": "app", "ready": true, "restartCount": int64(0)},
+			map[string]interface{}{"name": "sidecar", "ready": false, "restartCount": int64(3)},
+		},
+		"labels": map[string]interface{}{"app.kubernetes.io/name": "web"},
+	}
+
+	tests := []struct {
+		path     string
+		expected string // formatted value, empty when the path selects nothing
+	}{
+		{path: "status.succeeded", expected: "2"},
+		{path: "$.status.succeeded", expected: "2"},
+		{path: ".status.conditions[0].type", expected: "Ready"},
+		{path: "status.conditions[-1].type", expected: "Progressing"},
+		{path: "status.conditions[2].type"},
+		{path: "status.conditions[*].status", expected: "[True False]"},
+		{path: `status.conditions[?(@.type=="Ready")].status`, expected: "[True]"},
+		{path: `status.conditions[?(@.type=='Ready')].status`, expected: "[True]"},
+		{path: `containerStatuses[?(@.restartCount>0)].name`, expected: "[sidecar]"},
+		{path: `containerStatuses[?(@.name=~"^side")].ready`, expected: "[false]"},
+		{path: "containerStatuses[?(@.name)].name", expected: "[app sidecar]"},
+		{path: `containerStatuses[?(@.name=="db")].ready`},
+		{path: `labels["app.kubernetes.io/name"]`, expected: "web"},
+		{path: "labels.*", expected: "[web]"},
+		{path: "length(status.conditions)", expected: "2"},
+		{path: `length(containerStatuses[?(@.ready==true)])`, expected: "1"},
+		{path: `length(containerStatuses[?(@.name=="db")])`, expected: "0"},
+		{path: "length(status.missing)"},
+		{path: "status.succeeded.value"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			path, err := parseFieldPath(tt.path)
+			if err != nil {
+				t.Fatalf("parseFieldPath(%q) unexpected error: %v", tt.path, err)
+			}
+			value, found := path.lookup(feedback)
+			got := ""
+			if found {
+				got = fmt.Sprintf("%v", value)
+			}
+			if got != tt.expected {
+				t.Errorf("lookup(%q) = %q, expected %q", tt.path, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFieldPathComparison(t *testing.T) {
+	feedback := map[string]interface{}{
+		"containerStatuses": []interface{}{
+			map[string]interface{}{"name": "app", "ready": true},
+			map[string]interface{}{"name": "sidecar", "ready": false},
+		},
+	}
+
+	tests := []struct {
+		path     string
+		operator string
+		value    string
+		expected bool
+	}{
+		{path: "containerStatuses[*].ready", operator: "=", value: "false", expected: true},
+		{path: "containerStatuses[*].ready", operator: "!=", value: "false", expected: false},
+		{path: `containerStatuses[?(@.name=="app")].ready`, operator: "!=", value: "false", expected: true},
+		{path: "containerStatuses[*].name", operator: "=~", value: "^side", expected: true},
+		{path: "length(containerStatuses)", operator: ">=", value: "2", expected: true},
+		{path: "containerStatuses[5].ready", operator: "!=", value: "true", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path+tt.operator+tt.value, func(t *testing.T) {
+			path, err := parseFieldPath(tt.path)
+			if err != nil {
+				t.Fatalf("parseFieldPath(%q) unexpected error: %v", tt.path, err)
+			}
+			actual, _ := path.lookup(feedback)
+			if got := evaluateComparison(actual, tt.operator, tt.value); got != tt.expected {
+				t.Errorf("%s%s%s = %v, expected %v", tt.path, tt.operator, tt.value, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseFieldPathErrors(t *testing.T) {
+	for _, path := range []string{
+		"",
+		"status..succeeded",
+		"status.conditions[0",
+		"status.conditions[first]",
+		"status.conditions[0]type",
+		"status.conditions[?(type==Ready)]",
+		`status.conditions[?(@.type=~"(")]`,
+		"length(status.conditions",
+	} {
+		t.Run(path, func(t *testing.T) {
+			if _, err := parseFieldPath(path); err == nil {
+				t.Errorf("parseFieldPath(%q) expected error, got none", path)
+			}
+		})
+	}
+}
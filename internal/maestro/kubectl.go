@@ -0,0 +1,123 @@
+package maestro
+
+import (
+	"fmt"
+	"strings"
+
+	clierrors "github.com/openshift-hyperfleet/maestro-cli/pkg/errors"
+)
+
+// WaitTarget is what a wait is for: a condition expression or the deletion of the ManifestWork
+type WaitTarget struct {
+	Condition string
+	Delete    bool
+}
+
+// ParseWaitFor translates the kubectl wait forms of --for into a condition expression:
+//
+//	condition=Available                     Available
+//	condition=Available=False               Available=False
+//	delete                                  the ManifestWork is deleted
+//	jsonpath='{.status.succeeded}'=1        any resource has status feedback .status.succeeded = 1
+//	job/pi condition=Complete               job/pi:Complete
+//	job/pi jsonpath='{.status.succeeded}'=1 job/pi has status feedback .status.succeeded = 1
+//
+// The resource of the resource-scoped forms is TYPE[.GROUP]/NAME, where TYPE is a kind or a plural
+// resource name such as jobs. Feedback values are named after fields, so a jsonpath under .status
+// also matches the value of the same name, e.g. succeeded. Any other value is returned as is.
+func ParseWaitFor(value string) (WaitTarget, error) {
+	value = strings.TrimSpace(value)
+	invalid := func(format string, args ...interface{}) error {
+		return clierrors.NewValidationFailed("invalid --for %q: %s", value, fmt.Sprintf(format, args...))
+	}
+
+	resource, form := "", value
+	if i := strings.IndexAny(value, " \t"); i > 0 && strings.Contains(value[:i], "/") {
+		if rest := strings.TrimSpace(value[i:]); isKubectlWaitFor(rest) {
+			resource, form = value[:i], rest
+		}
+	}
+	if !isKubectlWaitFor(form) {
+		return WaitTarget{Condition: value}, nil
+	}
+
+	selector := "*"
+	if resource != "" {
+		kind, name, _ := strings.Cut(resource, "/")
+		kind, _, _ = strings.Cut(kind, ".") // job.batch is matched by kind
+		selector = kind + "/" + name
+		if _, err := parseResourceSelector(selector); err != nil {
+			return WaitTarget{}, invalid("%v", err)
+		}
+	}
+
+	switch {
+	case form == "delete":
+		if resource != "" {
+			return WaitTarget{}, invalid("only the deletion of the ManifestWork can be awaited, not of %s", resource)
+		}
+		return WaitTarget{Delete: true}, nil
+
+	case strings.HasPrefix(form, "condition="):
+		condType, status, hasStatus := strings.Cut(strings.TrimPrefix(form, "condition="), "=")
+		if condType == "" {
+			return WaitTarget{}, invalid("expected a condition name after condition=")
+		}
+		if !hasStatus || strings.EqualFold(status, statusTrue) {
+			if resource != "" {
+				return WaitTarget{Condition: selector + ":" + quoteConditionValue(condType)}, nil
+			}
+			return WaitTarget{Condition: quoteConditionValue(condType)}, nil
+		}
+		if resource != "" {
+			return WaitTarget{}, invalid("conditions of %s can only be awaited True", resource)
+		}
+		return WaitTarget{Condition: quoteConditionValue(condType) + "=" + quoteConditionValue(status)}, nil
+
+	default:
+		path, expected, err := parseKubectlJSONPath(strings.TrimPrefix(form, "jsonpath="))
+		if err != nil {
+			return WaitTarget{}, invalid("%v", err)
+		}
+		comparison := func(path string) string {
+			return fmt.Sprintf("%s:%s=%s", selector, quoteConditionValue(path), quoteConditionValue(expected))
+		}
+		condition := comparison(path)
+		if field := strings.TrimPrefix(path, ".status."); field != path {
+			condition = fmt.Sprintf("(%s OR %s)", condition, comparison(field))
+		}
+		return WaitTarget{Condition: condition}, nil
+	}
+}
+
+// isKubectlWaitFor reports whether value is one of the kubectl wait forms of --for
+func isKubectlWaitFor(value string) bool {
+	return value == "delete" || strings.HasPrefix(value, "condition=") || strings.HasPrefix(value, "jsonpath=")
+}
+
+// parseKubectlJSONPath parses '{.path}'=value, with or without quotes around the template and the value
+func parseKubectlJSONPath(value string) (path, expected string, err error) {
+	template, rest := value, ""
+	if value != "" && (value[0] == '\'' || value[0] == '"') {
+		end := strings.IndexByte(value[1:], value[0])
+		if end < 0 {
+			return "", "", fmt.Errorf("unterminated quote in jsonpath")
+		}
+		template, rest = value[1:end+1], value[end+2:]
+	} else if end := strings.LastIndex(value, "}"); end >= 0 {
+		template, rest = value[:end+1], value[end+1:]
+	}
+
+	if !strings.HasPrefix(template, "{") || !strings.HasSuffix(template, "}") {
+		return "", "", fmt.Errorf("expected a jsonpath template such as '{.status.succeeded}'")
+	}
+	path = strings.TrimSpace(template[1 : len(template)-1])
+	if path == "" {
+		return "", "", fmt.Errorf("empty jsonpath template")
+	}
+	if !strings.HasPrefix(rest, "=") {
+		return "", "", fmt.Errorf(
+			"expected =VALUE after the jsonpath template, waiting for a field to exist is not supported")
+	}
+	return path, unquotePathLiteral(rest[1:]), nil
+}
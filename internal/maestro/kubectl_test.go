@@ -0,0 +1,118 @@
+package maestro
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
+)
+
+func TestParseWaitFor(t *testing.T) {
+	tests := []struct {
+		value     string
+		condition string
+		delete    bool
+	}{
+		{value: "Available AND Job:Complete", condition: "Available AND Job:Complete"},
+		{value: "condition=Available", condition: "Available"},
+		{value: "condition=Available=True", condition: "Available"},
+		{value: "condition=Available=False", condition: "Available=False"},
+		{value: "delete", delete: true},
+		{
+			value:     "jsonpath='{.status.succeeded}'=1",
+			condition: "(*:.status.succeeded=1 OR *:succeeded=1)",
+		},
+		{value: "jsonpath={.phase}=Running", condition: "*:.phase=Running"},
+		{value: "job/test-job-1 condition=Complete", condition: "job/test-job-1:Complete"},
+		{value: "jobs.batch/pi  condition=Complete", condition: "jobs/pi:Complete"},
+		{
+			value:     `pod/web jsonpath='{.containerStatuses[?(@.name=="app")].ready}'=true`,
+			condition: `pod/web:".containerStatuses[?(@.name==\"app\")].ready"=true`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			target, err := ParseWaitFor(tt.value)
+			if err != nil {
+				t.Fatalf("ParseWaitFor(%q) unexpected error: %v", tt.value, err)
+			}
+			if target.Condition != tt.condition || target.Delete != tt.delete {
+				t.Errorf("ParseWaitFor(%q) = %+v, expected condition %q delete %v",
+					tt.value, target, tt.condition, tt.delete)
+			}
+			if target.Condition == "" {
+				return
+			}
+			if _, err := ParseCondition(target.Condition); err != nil {
+				t.Errorf("ParseCondition(%q) unexpected error: %v", target.Condition, err)
+			}
+		})
+	}
+}
+
+func TestParseWaitForErrors(t *testing.T) {
+	for _, value := range []string{
+		"condition=",
+		"job/pi delete",
+		"job/pi condition=Complete=False",
+		"jsonpath='{.status.succeeded}'",
+		"jsonpath='{.status.succeeded}=1",
+		"jsonpath=.status.succeeded=1",
+		"jsonpath={}=1",
+		"job//pi condition=Complete",
+	} {
+		t.Run(value, func(t *testing.T) {
+			if _, err := ParseWaitFor(value); err == nil {
+				t.Errorf("ParseWaitFor(%q) expected error, got none", value)
+			}
+		})
+	}
+}
+
+func TestParseWaitForEvaluate(t *testing.T) {
+	details := &ManifestWorkDetails{
+		Conditions: []ConditionSummary{
+			{Type: "Applied", Status: "True"},
+			{Type: "Degraded", Status: "False"},
+		},
+		ResourceStatus: []ResourceStatusInfo{
+			{
+				Kind:           "Job",
+				Resource:       "jobs",
+				Name:           "pi",
+				StatusFeedback: map[string]interface{}{"succeeded": int64(1)},
+				Conditions:     []ConditionSummary{{Type: "Available", Status: "True"}},
+			},
+		},
+	}
+
+	tests := []struct {
+		value    string
+		expected bool
+	}{
+		{value: "condition=Degraded=False", expected: true},
+		{value: "condition=Degraded", expected: false},
+		{value: "condition=Available=False", expected: false},
+		{value: "jsonpath='{.status.succeeded}'=1", expected: true},
+		{value: "jobs/pi condition=Available", expected: true},
+		{value: "job.batch/pi jsonpath='{.status.succeeded}'=2", expected: false},
+	}
+
+	log := logger.New(logger.Config{Level: "error", Format: "text"})
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			target, err := ParseWaitFor(tt.value)
+			if err != nil {
+				t.Fatalf("ParseWaitFor(%q) unexpected error: %v", tt.value, err)
+			}
+			condition, err := ParseCondition(target.Condition)
+			if err != nil {
+				t.Fatalf("ParseCondition(%q) unexpected error: %v", target.Condition, err)
+			}
+			if got := condition.Evaluate(context.Background(), details, log); got != tt.expected {
+				t.Errorf("Evaluate(%q) = %v, expected %v", target.Condition, got, tt.expected)
+			}
+		})
+	}
+}
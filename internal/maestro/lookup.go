@@ -0,0 +1,104 @@
+package maestro
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"k8s.io/apimachinery/pkg/api/errors"
+	workv1 "open-cluster-management.io/api/work/v1"
+)
+
+// bundleNameField is the Maestro search field holding the ManifestWork name of a resource bundle
+const bundleNameField = "payload->'metadata'->>'name'"
+
+// bundleIDCache maps consumer/name to the resource bundle ID found by a previous lookup
+type bundleIDCache struct {
+	mu  sync.RWMutex
+	ids map[string]string
+}
+
+func (b *bundleIDCache) get(key string) (string, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	id, ok := b.ids[key]
+	return id, ok
+}
+
+func (b *bundleIDCache) set(key, id string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.ids == nil {
+		b.ids = make(map[string]string)
+	}
+	b.ids[key] = id
+}
+
+func (b *bundleIDCache) forget(key string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.ids, key)
+}
+
+// findResourceBundle looks up the resource bundle of a ManifestWork by consumer and name.
+// Once a bundle has been found its ID is cached, so later lookups (e.g. every poll of wait)
+// fetch it directly by ID. Otherwise the name predicate is pushed into the Maestro search
+// query, so only the matching bundle is returned instead of every bundle of the consumer.
+func (c *Client) findResourceBundle(ctx context.Context, consumer, name string) (*openapi.ResourceBundle, error) {
+	if err := validateSearchQuery(consumer); err != nil {
+		return nil, fmt.Errorf("invalid consumer name: %w", err)
+	}
+	if err := validateSearchQuery(name); err != nil {
+		return nil, fmt.Errorf("invalid ManifestWork name: %w", err)
+	}
+
+	key := consumer + "/" + name
+	if id, ok := c.bundleIDs.get(key); ok {
+		rb, resp, err := c.httpClient.DefaultAPI.ApiMaestroV1ResourceBundlesIdGet(ctx, id).Execute()
+		switch {
+		case err == nil && bundleMatches(rb, consumer, name):
+			return rb, nil
+		case err == nil || (resp != nil && resp.StatusCode == http.StatusNotFound):
+			// The bundle was deleted or recreated under another ID, search for it again
+			c.bundleIDs.forget(key)
+		default:
+			return nil, newAPIError("failed to get resource bundle", resp, err)
+		}
+	}
+
+	search := fmt.Sprintf("consumer_name = '%s' and %s = '%s'", consumer, bundleNameField, name)
+
+	resourceList, resp, err := c.httpClient.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).
+		Search(search).
+		Execute()
+	if err != nil {
+		return nil, newAPIError("failed to search resource bundles", resp, err)
+	}
+
+	for i := range resourceList.Items {
+		rb := &resourceList.Items[i]
+		if !bundleMatches(rb, consumer, name) {
+			continue
+		}
+		if rb.Id != nil {
+			c.bundleIDs.set(key, *rb.Id)
+		}
+		return rb, nil
+	}
+
+	return nil, errors.NewNotFound(workv1.Resource("manifestwork"), name)
+}
+
+// bundleMatches reports whether a resource bundle belongs to the given consumer and ManifestWork name
+func bundleMatches(rb *openapi.ResourceBundle, consumer, name string) bool {
+	if rb == nil || rb.Metadata == nil {
+		return false
+	}
+	if rb.ConsumerName != nil && *rb.ConsumerName != consumer {
+		return false
+	}
+	rbName, _ := rb.Metadata["name"].(string)
+	return rbName == name
+}
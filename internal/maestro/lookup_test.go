@@ -0,0 +1,87 @@
+package maestro
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+func TestFindResourceBundleCachesID(t *testing.T) {
+	const bundleID = "3f1c2a9e-0000-0000-0000-000000000001"
+	bundle := map[string]interface{}{
+		"id":            bundleID,
+		"consumer_name": "agent1",
+		"version":       1,
+		"metadata":      map[string]interface{}{"name": "test-mw"},
+	}
+
+	var searches []string
+	var idGets int
+	deleted := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/maestro/v1/resource-bundles":
+			searches = append(searches, r.URL.Query().Get("search"))
+			items := []interface{}{}
+			if !deleted {
+				items = append(items, bundle)
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"kind":  "ResourceBundleList",
+				"page":  1,
+				"size":  len(items),
+				"total": len(items),
+				"items": items,
+			})
+		case "/api/maestro/v1/resource-bundles/" + bundleID:
+			idGets++
+			if deleted {
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{"kind": "Error", "code": "maestro-7"})
+				return
+			}
+			_ = json.NewEncoder(w).Encode(bundle)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewHTTPClient(ClientConfig{HTTPEndpoint: server.URL})
+	if err != nil {
+		t.Fatalf("NewHTTPClient() unexpected error: %v", err)
+	}
+	ctx := context.Background()
+
+	// First lookup searches with the name pushed into the query
+	if _, err := client.GetManifestWorkByNameHTTP(ctx, "agent1", "test-mw"); err != nil {
+		t.Fatalf("first lookup unexpected error: %v", err)
+	}
+	if len(searches) != 1 || !strings.Contains(searches[0], "->>'name' = 'test-mw'") {
+		t.Fatalf("expected a single search with the name predicate, got %q", searches)
+	}
+
+	// Later lookups go straight to the bundle by ID
+	if _, err := client.GetManifestWorkDetailsHTTP(ctx, "agent1", "test-mw"); err != nil {
+		t.Fatalf("second lookup unexpected error: %v", err)
+	}
+	if len(searches) != 1 || idGets != 1 {
+		t.Errorf("expected cached ID lookup, got %d searches and %d ID gets", len(searches), idGets)
+	}
+
+	// A deleted bundle is dropped from the cache and reported as not found
+	deleted = true
+	_, err = client.GetManifestWorkByNameHTTP(ctx, "agent1", "test-mw")
+	if !errors.IsNotFound(err) {
+		t.Fatalf("expected NotFound after deletion, got %v", err)
+	}
+	if _, ok := client.bundleIDs.get("agent1/test-mw"); ok {
+		t.Error("expected cached ID to be forgotten after deletion")
+	}
+}
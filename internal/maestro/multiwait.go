@@ -0,0 +1,281 @@
+package maestro
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	clierrors "github.com/openshift-hyperfleet/maestro-cli/pkg/errors"
+	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
+)
+
+// Modes of a wait on several ManifestWorks
+const (
+	// WaitModeAll returns once every selected ManifestWork meets the condition
+	WaitModeAll = "all"
+	// WaitModeAny returns once one selected ManifestWork meets the condition
+	WaitModeAny = "any"
+)
+
+// WorkSelection selects the ManifestWorks of a consumer that are awaited together
+type WorkSelection struct {
+	Names    []string        // Explicit ManifestWork names (--names)
+	All      bool            // Every ManifestWork of the consumer (--all)
+	Selector labels.Selector // Selector matched against the ManifestWork labels (--selector)
+}
+
+// matches reports whether the selection includes the ManifestWork
+func (s WorkSelection) matches(details *ManifestWorkDetails) bool {
+	switch {
+	case len(s.Names) > 0:
+		for _, name := range s.Names {
+			if name == details.Name {
+				return true
+			}
+		}
+		return false
+	case s.Selector != nil:
+		return s.Selector.Matches(labels.Set(details.Labels))
+	default:
+		return s.All
+	}
+}
+
+// WorkWaitResult is the outcome of a multi-work wait for one ManifestWork
+type WorkWaitResult struct {
+	Name string
+	// Details is the last status received, nil if the ManifestWork was deleted during the wait
+	Details *ManifestWorkDetails
+	// Met reports whether the condition held when the wait returned
+	Met bool
+	// Err is a ConditionFailedError when --fail-on matched, or a ConditionUnmetError when the
+	// wait ended before the condition was met; nil otherwise, also for works still pending
+	// when another work satisfied a WaitModeAny wait
+	Err error
+}
+
+// workWait is the state of one ManifestWork during a multi-work wait
+type workWait struct {
+	condition *Condition // compiled per work, so each work has its own stability windows
+	failOn    *Condition
+	result    WorkWaitResult
+}
+
+// WaitForWorks waits for a condition expression on several ManifestWorks of a consumer at once.
+// Every poll lists the ManifestWorks of the consumer once over HTTP and evaluates the condition and
+// the FailOn expression of WaitOptions on each selected work. With WaitModeAll the wait returns once
+// every work meets the condition, or as soon as one fails; with WaitModeAny once one work meets it,
+// or once every work failed. Works matching --all or a selector that appear during the wait are
+// awaited too. Results are sorted by name; the error is only set when the wait could not run, e.g.
+// a named ManifestWork does not exist or no ManifestWork matches the selection.
+func (c *Client) WaitForWorks(
+	ctx context.Context,
+	consumer string,
+	selection WorkSelection,
+	conditionExpr string,
+	opts WaitOptions,
+	log *logger.Logger,
+) ([]WorkWaitResult, error) {
+	// Compile once up front so syntax errors are returned before polling
+	if _, err := ParseCondition(conditionExpr); err != nil {
+		return nil, err
+	}
+	if opts.FailOn != "" {
+		if _, err := ParseCondition(opts.FailOn); err != nil {
+			return nil, err
+		}
+	}
+	mode := opts.Mode
+	if mode == "" {
+		mode = WaitModeAll
+	}
+	if mode != WaitModeAll && mode != WaitModeAny {
+		return nil, clierrors.NewValidationFailed("invalid wait mode %q, expected %s or %s",
+			mode, WaitModeAll, WaitModeAny)
+	}
+	pollInterval := opts.PollInterval
+	if pollInterval == 0 {
+		pollInterval = DefaultPollInterval
+	}
+
+	works := make(map[string]*workWait)
+	track := func(details *ManifestWorkDetails) *workWait {
+		work, ok := works[details.Name]
+		if !ok {
+			// Parsing cannot fail, the expressions were compiled above
+			work = &workWait{result: WorkWaitResult{Name: details.Name}}
+			work.condition, _ = ParseCondition(conditionExpr)
+			if opts.StableFor > 0 {
+				work.condition.stableFor(opts.StableFor)
+			}
+			if opts.FailOn != "" {
+				work.failOn, _ = ParseCondition(opts.FailOn)
+			}
+			works[details.Name] = work
+		}
+		return work
+	}
+
+	// poll lists the ManifestWorks of the consumer and records the status of the selected ones
+	poll := func() error {
+		listed, err := c.ListManifestWorkDetailsHTTP(ctx, consumer)
+		if err != nil {
+			return err
+		}
+		seen := make(map[string]bool, len(listed))
+		for _, details := range listed {
+			if selection.matches(details) {
+				track(details).result.Details = details
+				seen[details.Name] = true
+			}
+		}
+		for name, work := range works {
+			if !seen[name] && work.result.Details != nil {
+				log.Warn(ctx, "ManifestWork was deleted while waiting for condition", logger.Fields{"name": name})
+				work.result.Details = nil
+			}
+		}
+		return nil
+	}
+
+	// evaluate checks every work against its last status and reports whether the wait is over
+	// While a stability window is running, recheck fires when it completes.
+	var recheck <-chan time.Time
+	evaluate := func() bool {
+		met, failed := 0, 0
+		var until time.Time
+		for name, work := range works {
+			result := &work.result
+			if result.Err != nil {
+				failed++
+				continue
+			}
+			result.Met = false
+			if result.Details == nil {
+				continue
+			}
+			if matched, ok := work.failOn.Match(ctx, result.Details, log); ok {
+				log.Warn(ctx, "Fail-on condition met", logger.Fields{
+					"fail_on": opts.FailOn,
+					"matched": matched,
+					"name":    name,
+				})
+				result.Err = &ConditionFailedError{Name: name, FailOn: opts.FailOn, Matched: matched}
+				failed++
+				continue
+			}
+			if work.condition.Evaluate(ctx, result.Details, log) {
+				result.Met = true
+				met++
+			}
+			until = earliest(until, earliest(work.condition.pendingUntil(), work.failOn.pendingUntil()))
+		}
+		recheck = nil
+		if !until.IsZero() {
+			recheck = time.After(time.Until(until))
+		}
+
+		log.Debug(ctx, "Evaluated ManifestWorks", logger.Fields{
+			"met":    met,
+			"failed": failed,
+			"total":  len(works),
+		})
+		if mode == WaitModeAny {
+			return met > 0 || failed == len(works)
+		}
+		return failed > 0 || met == len(works)
+	}
+
+	// First check the current status, which must include every named work
+	if err := poll(); err != nil {
+		return nil, fmt.Errorf("failed to list ManifestWorks: %w", err)
+	}
+	var missing []string
+	for _, name := range selection.Names {
+		if _, ok := works[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, clierrors.NewNotFound("ManifestWork(s) not found in consumer %q: %s",
+			consumer, strings.Join(missing, ", "))
+	}
+	if len(works) == 0 {
+		if selection.Selector != nil {
+			return nil, clierrors.NewNotFound("no ManifestWorks match selector %q in consumer %q",
+				selection.Selector.String(), consumer)
+		}
+		return nil, clierrors.NewNotFound("no ManifestWorks found in consumer %q", consumer)
+	}
+
+	if evaluate() {
+		return sortedWorkResults(works), nil
+	}
+
+	log.Info(ctx, "Polling for condition on ManifestWorks", logger.Fields{
+		"consumer":      consumer,
+		"works":         len(works),
+		"condition":     conditionExpr,
+		"mode":          mode,
+		"poll_interval": pollInterval.String(),
+	})
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Warn(ctx, "Context cancelled while waiting for condition", logger.Fields{
+				"condition": conditionExpr,
+				"error":     ctx.Err().Error(),
+			})
+			// Explain why each work still waiting did not meet the condition
+			for name, work := range works {
+				if work.result.Met || work.result.Err != nil {
+					continue
+				}
+				work.result.Err = &ConditionUnmetError{
+					Name:      name,
+					Condition: conditionExpr,
+					Trace:     work.condition.Trace(ctx, work.result.Details, log),
+					Err:       ctx.Err(),
+				}
+			}
+			return sortedWorkResults(works), nil
+		case <-ticker.C:
+			if err := poll(); err != nil {
+				// The poll failed because ctx ended while it was in flight
+				if ctx.Err() != nil {
+					continue
+				}
+				// Terminal errors (e.g. unauthorized) will not resolve by polling again
+				if !IsRetryableError(err) {
+					return nil, fmt.Errorf("failed to poll ManifestWorks: %w", err)
+				}
+				log.Warn(ctx, "Failed to poll ManifestWorks", logger.Fields{"error": err.Error()})
+				continue
+			}
+		case <-recheck:
+			// A stability window completes; the last statuses are evaluated again
+		}
+
+		if evaluate() {
+			return sortedWorkResults(works), nil
+		}
+	}
+}
+
+// sortedWorkResults returns the results of a multi-work wait sorted by ManifestWork name
+func sortedWorkResults(works map[string]*workWait) []WorkWaitResult {
+	results := make([]WorkWaitResult, 0, len(works))
+	for _, work := range works {
+		results = append(results, work.result)
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Name < results[j].Name })
+	return results
+}
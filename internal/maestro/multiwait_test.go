@@ -0,0 +1,140 @@
+package maestro
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	clierrors "github.com/openshift-hyperfleet/maestro-cli/pkg/errors"
+	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
+)
+
+func TestWaitForWorks(t *testing.T) {
+	bundle := func(name, app, available, degraded string) interface{} {
+		return map[string]interface{}{
+			"id":            "id-" + name,
+			"consumer_name": "agent1",
+			"version":       1,
+			"metadata":      map[string]interface{}{"name": name, "labels": map[string]interface{}{"app": app}},
+			"status": map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Applied", "status": "True"},
+					map[string]interface{}{"type": "Available", "status": available},
+					map[string]interface{}{"type": "Degraded", "status": degraded},
+				},
+			},
+		}
+	}
+	items := []interface{}{
+		bundle("api", "web", "True", "False"),
+		bundle("db", "data", "True", "True"),
+		bundle("ui", "web", "False", "False"),
+	}
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"kind":  "ResourceBundleList",
+			"page":  1,
+			"size":  len(items),
+			"total": len(items),
+			"items": items,
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewHTTPClient(ClientConfig{HTTPEndpoint: server.URL})
+	if err != nil {
+		t.Fatalf("NewHTTPClient() unexpected error: %v", err)
+	}
+	log := logger.New(logger.Config{Level: "error", Format: "text"})
+	web := labels.SelectorFromSet(labels.Set{"app": "web"})
+
+	tests := []struct {
+		name      string
+		selection WorkSelection
+		opts      WaitOptions
+		expected  string // name:outcome of each work, outcome being met, waiting, failed or unmet
+		polls     int32  // expected list calls, 0 when the wait times out
+	}{
+		{
+			name:      "all named works met",
+			selection: WorkSelection{Names: []string{"api", "db"}},
+			expected:  "[api:met db:met]",
+			polls:     1,
+		},
+		{
+			name:      "any selected work met",
+			selection: WorkSelection{Selector: web},
+			opts:      WaitOptions{Mode: WaitModeAny},
+			expected:  "[api:met ui:waiting]",
+			polls:     1,
+		},
+		{
+			name:      "all selected works until timeout",
+			selection: WorkSelection{Selector: web},
+			expected:  "[api:met ui:unmet]",
+		},
+		{
+			name:      "fail-on ends a wait for all works",
+			selection: WorkSelection{All: true},
+			opts:      WaitOptions{FailOn: "Degraded"},
+			expected:  "[api:met db:failed ui:waiting]",
+			polls:     1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			requests.Store(0)
+			tt.opts.PollInterval = 20 * time.Millisecond
+
+			results, err := client.WaitForWorks(ctx, "agent1", tt.selection, "Available", tt.opts, log)
+			if err != nil {
+				t.Fatalf("WaitForWorks() unexpected error: %v", err)
+			}
+			var outcomes []string
+			for _, r := range results {
+				outcome := "waiting"
+				var unmetErr *ConditionUnmetError
+				var failedErr *ConditionFailedError
+				switch {
+				case r.Met:
+					outcome = "met"
+				case errors.As(r.Err, &failedErr):
+					outcome = "failed"
+				case errors.As(r.Err, &unmetErr) && unmetErr.Trace != nil:
+					outcome = "unmet"
+				}
+				outcomes = append(outcomes, r.Name+":"+outcome)
+			}
+			if got := fmt.Sprint(outcomes); got != tt.expected {
+				t.Errorf("outcomes = %s, expected %s", got, tt.expected)
+			}
+			if tt.polls > 0 && requests.Load() != tt.polls {
+				t.Errorf("expected %d list call(s), got %d", tt.polls, requests.Load())
+			}
+		})
+	}
+
+	_, err = client.WaitForWorks(context.Background(), "agent1", WorkSelection{Names: []string{"api", "cache"}},
+		"Available", WaitOptions{}, log)
+	if got := clierrors.FromError(err).Type; got != clierrors.TypeNotFound {
+		t.Errorf("expected error type %s for a missing work, got %s (%v)", clierrors.TypeNotFound, got, err)
+	}
+	_, err = client.WaitForWorks(context.Background(), "agent1",
+		WorkSelection{Selector: labels.SelectorFromSet(labels.Set{"app": "none"})}, "Available", WaitOptions{}, log)
+	if got := clierrors.FromError(err).Type; got != clierrors.TypeNotFound {
+		t.Errorf("expected error type %s when no work matches, got %s (%v)", clierrors.TypeNotFound, got, err)
+	}
+}
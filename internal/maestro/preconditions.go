@@ -0,0 +1,96 @@
+package maestro
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	clierrors "github.com/openshift-hyperfleet/maestro-cli/pkg/errors"
+)
+
+// ApplyPreconditions guard an apply against concurrent writers using the resource bundle version
+// A zero value has no preconditions.
+type ApplyPreconditions struct {
+	// ExpectedVersion is the version the ManifestWork must have; 0 requires that it does not exist
+	ExpectedVersion *int32
+	// UnchangedSince requires that the ManifestWork was not updated after this time
+	UnchangedSince time.Time
+}
+
+// IsZero reports whether no precondition is set
+func (p ApplyPreconditions) IsZero() bool {
+	return p.ExpectedVersion == nil && p.UnchangedSince.IsZero()
+}
+
+// VersionConflictError reports a ManifestWork changed by another writer since the caller read it
+type VersionConflictError struct {
+	Name   string
+	Reason string
+}
+
+// Error implements error
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("ManifestWork %s was modified concurrently: %s", e.Name, e.Reason)
+}
+
+// ErrorType classifies the error as a conflict with the existing ManifestWork
+func (e *VersionConflictError) ErrorType() clierrors.Type {
+	return clierrors.TypeConflict
+}
+
+// IsVersionConflict reports whether err is a failed precondition or a version conflict reported by Maestro
+// Maestro rejects updates that are not based on the latest version of a resource bundle.
+func IsVersionConflict(err error) bool {
+	var conflictErr *VersionConflictError
+	return errors.As(err, &conflictErr) || apierrors.IsConflict(err)
+}
+
+// CheckApplyPreconditions checks the resource bundle of a ManifestWork against the preconditions
+// It returns the current version (0 when the work does not exist) or a *VersionConflictError.
+// The check reads the database through the HTTP API.
+func (c *Client) CheckApplyPreconditions(
+	ctx context.Context,
+	consumer, name string,
+	preconditions ApplyPreconditions,
+) (int32, error) {
+	bundle, err := c.GetResourceBundleFullHTTP(ctx, consumer, name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return 0, fmt.Errorf("failed to check existing work: %w", err)
+	}
+
+	var version int32
+	if bundle != nil {
+		version = bundle.Version
+	}
+
+	if expected := preconditions.ExpectedVersion; expected != nil && *expected != version {
+		reason := fmt.Sprintf("expected version %d, found version %d", *expected, version)
+		switch {
+		case bundle == nil:
+			reason = fmt.Sprintf("expected version %d, but it does not exist", *expected)
+		case *expected == 0:
+			reason = fmt.Sprintf("expected it not to exist, found version %d", version)
+		}
+		return version, &VersionConflictError{Name: name, Reason: reason}
+	}
+
+	if since := preconditions.UnchangedSince; !since.IsZero() && bundle != nil {
+		updatedAt, err := time.Parse(time.RFC3339, bundle.UpdatedAt)
+		if err != nil {
+			return version, fmt.Errorf("failed to parse update time %q of ManifestWork %s: %w",
+				bundle.UpdatedAt, name, err)
+		}
+		if updatedAt.After(since) {
+			return version, &VersionConflictError{
+				Name: name,
+				Reason: fmt.Sprintf("updated at %s (version %d), after %s",
+					bundle.UpdatedAt, version, since.Format(time.RFC3339)),
+			}
+		}
+	}
+
+	return version, nil
+}
@@ -0,0 +1,157 @@
+package maestro
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	clierrors "github.com/openshift-hyperfleet/maestro-cli/pkg/errors"
+)
+
+func TestCheckApplyPreconditions(t *testing.T) {
+	bundle := map[string]interface{}{
+		"id":            "3f1c2a9e-0000-0000-0000-000000000003",
+		"consumer_name": "agent1",
+		"version":       3,
+		"updated_at":    "2024-05-01T10:00:00Z",
+		"metadata":      map[string]interface{}{"name": "test-mw"},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		items := []interface{}{}
+		if !strings.Contains(r.URL.Query().Get("search"), "'missing-mw'") {
+			items = append(items, bundle)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"kind":  "ResourceBundleList",
+			"page":  1,
+			"size":  len(items),
+			"total": len(items),
+			"items": items,
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewHTTPClient(ClientConfig{HTTPEndpoint: server.URL})
+	if err != nil {
+		t.Fatalf("NewHTTPClient() unexpected error: %v", err)
+	}
+
+	version := func(v int32) *int32 { return &v }
+	before := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	after := time.Date(2024, 5, 1, 11, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		work          string
+		preconditions ApplyPreconditions
+		wantVersion   int32
+		wantConflict  string
+	}{
+		{name: "no preconditions", work: "test-mw", wantVersion: 3},
+		{
+			name:          "expected version matches",
+			work:          "test-mw",
+			preconditions: ApplyPreconditions{ExpectedVersion: version(3)},
+			wantVersion:   3,
+		},
+		{
+			name:          "stale expected version",
+			work:          "test-mw",
+			preconditions: ApplyPreconditions{ExpectedVersion: version(2)},
+			wantVersion:   3,
+			wantConflict:  "expected version 2, found version 3",
+		},
+		{
+			name:          "expected not to exist",
+			work:          "test-mw",
+			preconditions: ApplyPreconditions{ExpectedVersion: version(0)},
+			wantVersion:   3,
+			wantConflict:  "expected it not to exist",
+		},
+		{
+			name:          "missing work expected not to exist",
+			work:          "missing-mw",
+			preconditions: ApplyPreconditions{ExpectedVersion: version(0)},
+		},
+		{
+			name:          "missing work with expected version",
+			work:          "missing-mw",
+			preconditions: ApplyPreconditions{ExpectedVersion: version(1)},
+			wantConflict:  "does not exist",
+		},
+		{
+			name:          "unchanged since",
+			work:          "test-mw",
+			preconditions: ApplyPreconditions{UnchangedSince: after},
+			wantVersion:   3,
+		},
+		{
+			name:          "updated since",
+			work:          "test-mw",
+			preconditions: ApplyPreconditions{UnchangedSince: before},
+			wantVersion:   3,
+			wantConflict:  "updated at 2024-05-01T10:00:00Z",
+		},
+		{
+			name:          "missing work is unchanged",
+			work:          "missing-mw",
+			preconditions: ApplyPreconditions{UnchangedSince: before},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := client.CheckApplyPreconditions(context.Background(), "agent1", tt.work, tt.preconditions)
+			if got != tt.wantVersion {
+				t.Errorf("version = %d, want %d", got, tt.wantVersion)
+			}
+			if tt.wantConflict == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			var conflictErr *VersionConflictError
+			if !errors.As(err, &conflictErr) {
+				t.Fatalf("expected VersionConflictError, got %v", err)
+			}
+			if !strings.Contains(err.Error(), tt.wantConflict) {
+				t.Errorf("error %q does not contain %q", err, tt.wantConflict)
+			}
+			if got := clierrors.FromError(err).Type; got != clierrors.TypeConflict {
+				t.Errorf("expected error type %s, got %s", clierrors.TypeConflict, got)
+			}
+		})
+	}
+}
+
+func TestIsVersionConflict(t *testing.T) {
+	gr := schema.GroupResource{Group: "work.open-cluster-management.io", Resource: "manifestworks"}
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "precondition", err: &VersionConflictError{Name: "w", Reason: "stale"}, want: true},
+		{name: "maestro conflict", err: apierrors.NewConflict(gr, "w", errors.New("not the latest")), want: true},
+		{name: "manifest count", err: &ManifestCountChangedError{Name: "w", Existing: 1, Desired: 2}},
+		{name: "not found", err: apierrors.NewNotFound(gr, "w")},
+		{name: "nil"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsVersionConflict(tt.err); got != tt.want {
+				t.Errorf("IsVersionConflict() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
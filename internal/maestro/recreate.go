@@ -0,0 +1,259 @@
+package maestro
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	workv1 "open-cluster-management.io/api/work/v1"
+
+	clierrors "github.com/openshift-hyperfleet/maestro-cli/pkg/errors"
+	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
+)
+
+// ApplyOperationRecreated is the operation of a ManifestWork deleted and created again by RecreateManifestWork
+const ApplyOperationRecreated = "Recreated"
+
+// Steps of a controlled recreate
+const (
+	RecreateStepOrphan          = "Orphan"
+	RecreateStepWaitForOrphan   = "WaitForOrphan"
+	RecreateStepDelete          = "Delete"
+	RecreateStepWaitForDeletion = "WaitForDeletion"
+	RecreateStepCreate          = "Create"
+	RecreateStepWait            = "Wait" // Recorded by callers that wait for the recreated work
+)
+
+// ManifestCountChangedError reports an update that changes the number of manifests of a ManifestWork,
+// which Maestro rejects; the work has to be recreated instead
+type ManifestCountChangedError struct {
+	Name     string
+	Existing int
+	Desired  int
+}
+
+// Error implements error
+func (e *ManifestCountChangedError) Error() string {
+	return fmt.Sprintf("ManifestWork %s has %d manifest(s) but the new spec has %d; Maestro cannot change the "+
+		"number of manifests in place (use --allow-recreate to delete and recreate it, orphaning its resources)",
+		e.Name, e.Existing, e.Desired)
+}
+
+// ErrorType classifies the error as a conflict with the existing ManifestWork
+func (e *ManifestCountChangedError) ErrorType() clierrors.Type {
+	return clierrors.TypeConflict
+}
+
+// CheckManifestCount compares the manifest count of a ManifestWork with its remote resource bundle
+// It returns a *ManifestCountChangedError when the counts differ and nil when they match or the
+// work does not exist yet.
+func (c *Client) CheckManifestCount(ctx context.Context, consumer string, manifestWork *workv1.ManifestWork) error {
+	existing, err := c.GetResourceBundleFullHTTP(ctx, consumer, manifestWork.Name)
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to check existing work: %w", err)
+	}
+
+	if desired := len(manifestWork.Spec.Workload.Manifests); len(existing.Manifests) != desired {
+		return &ManifestCountChangedError{Name: manifestWork.Name, Existing: len(existing.Manifests), Desired: desired}
+	}
+	return nil
+}
+
+// RecreateManifestWork replaces an existing ManifestWork by deleting and creating it again
+// The existing work is first switched to the Orphan delete propagation policy so the resources
+// it manages stay on the cluster, and the agent is awaited until it applied that generation of
+// the work. Only then is the work deleted, its removal awaited and the new work created, which
+// adopts the resources.
+// The steps carried out are returned even when one of them fails.
+func (c *Client) RecreateManifestWork(
+	ctx context.Context,
+	consumer string,
+	manifestWork *workv1.ManifestWork,
+	pollInterval time.Duration,
+	log *logger.Logger,
+) (*ApplyResult, []Step, error) {
+	if c.workClient == nil {
+		return nil, nil, fmt.Errorf("gRPC client not available: RecreateManifestWork requires gRPC connection")
+	}
+	manifestWork.Namespace = consumer
+
+	// The desired work may come from a fetched one (e.g. build); drop the fields of the deleted object
+	desired := manifestWork.DeepCopy()
+	desired.ResourceVersion = ""
+	desired.UID = ""
+	desired.Generation = 0
+	desired.CreationTimestamp = metav1.Time{}
+	desired.DeletionTimestamp = nil
+	desired.Status = workv1.ManifestWorkStatus{}
+	if err := setSpecHash(desired); err != nil {
+		return nil, nil, err
+	}
+
+	var steps []Step
+
+	existing, err := c.GetManifestWork(ctx, consumer, manifestWork.Name)
+	if err != nil {
+		steps = append(steps, NewStep(RecreateStepOrphan, "", err))
+		return nil, steps, fmt.Errorf("failed to get ManifestWork to recreate: %w", err)
+	}
+
+	log.Info(ctx, "Recreating ManifestWork", logger.Fields{
+		"manifest_name":      manifestWork.Name,
+		"consumer":           consumer,
+		"existing_manifests": len(existing.Spec.Workload.Manifests),
+		"desired_manifests":  len(manifestWork.Spec.Workload.Manifests),
+	})
+
+	orphan := &workv1.DeleteOption{PropagationPolicy: workv1.DeletePropagationPolicyTypeOrphan}
+	if existing.Spec.DeleteOption != nil && existing.Spec.DeleteOption.PropagationPolicy == orphan.PropagationPolicy {
+		steps = append(steps, NewStep(RecreateStepOrphan, "delete propagation policy is already Orphan", nil))
+	} else {
+		orphaned := existing.DeepCopy()
+		orphaned.Spec.DeleteOption = orphan
+		patchedAt := time.Now()
+		patched, err := c.PatchManifestWork(ctx, consumer, existing, orphaned, log)
+		steps = append(steps, NewStep(RecreateStepOrphan, "delete propagation policy set to Orphan", err))
+		if err != nil {
+			return nil, steps, fmt.Errorf("failed to set Orphan delete propagation policy: %w", err)
+		}
+
+		// Maestro increments the version of the work when it accepts the update. Deleting the work
+		// before the agent applied that version would delete its resources with it.
+		generation := max(patched.Generation, existing.Generation+1)
+		err = c.waitForAppliedGeneration(ctx, consumer, manifestWork.Name, generation, patchedAt, pollInterval, log)
+		steps = append(steps, NewStep(RecreateStepWaitForOrphan,
+			fmt.Sprintf("Orphan delete propagation policy applied (generation %d)", generation), err))
+		if err != nil {
+			return nil, steps, fmt.Errorf("failed waiting for the Orphan delete propagation policy to be applied: %w", err)
+		}
+	}
+
+	err = c.retry.Do(ctx, func() error {
+		err := c.DeleteManifestWork(ctx, consumer, manifestWork.Name)
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	})
+	steps = append(steps, NewStep(RecreateStepDelete, "ManifestWork deleted, resources orphaned", err))
+	if err != nil {
+		return nil, steps, fmt.Errorf("failed to delete ManifestWork: %w", err)
+	}
+
+	err = c.WaitForDeletion(ctx, consumer, manifestWork.Name, pollInterval, log)
+	steps = append(steps, NewStep(RecreateStepWaitForDeletion, "ManifestWork removed", err))
+	if err != nil {
+		return nil, steps, fmt.Errorf("failed waiting for ManifestWork deletion: %w", err)
+	}
+
+	created, err := c.createManifestWork(ctx, consumer, desired)
+	steps = append(steps, NewStep(RecreateStepCreate,
+		fmt.Sprintf("ManifestWork created with %d manifest(s)", len(manifestWork.Spec.Workload.Manifests)), err))
+	if err != nil {
+		return nil, steps, fmt.Errorf("failed to create ManifestWork: %w", err)
+	}
+
+	return &ApplyResult{ManifestWork: created, Operation: ApplyOperationRecreated, Previous: existing}, steps, nil
+}
+
+// waitForAppliedGeneration waits until the Applied condition of a ManifestWork is True and was
+// observed at the given generation or a later one. Without an observed generation, the condition
+// must have transitioned after the update to that generation was sent at updatedAt.
+// The status is taken from gRPC status updates when available, with HTTP polling as a fallback/resync.
+func (c *Client) waitForAppliedGeneration(
+	ctx context.Context,
+	consumer, workName string,
+	generation int64,
+	updatedAt time.Time,
+	pollInterval time.Duration,
+	log *logger.Logger,
+) error {
+	if pollInterval == 0 {
+		pollInterval = DefaultPollInterval
+	}
+
+	subCtx, cancelSub := context.WithCancel(ctx)
+	defer cancelSub()
+	events := c.SubscribeWorkStatus(subCtx, consumer, workName, log)
+
+	log.Info(ctx, "Waiting for ManifestWork generation to be applied", logger.Fields{
+		"name":          workName,
+		"consumer":      consumer,
+		"generation":    generation,
+		"poll_interval": pollInterval.String(),
+		"events":        events != nil,
+	})
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	eventDriven := false
+
+	notApplied := func() error {
+		return fmt.Errorf("the Applied condition was not reported for generation %d: %w", generation, ctx.Err())
+	}
+
+	details, err := c.GetManifestWorkDetailsHTTP(ctx, consumer, workName)
+	for {
+		// The poll failed because ctx ended while it was in flight
+		if err != nil && ctx.Err() != nil {
+			return notApplied()
+		}
+		if err != nil && !IsRetryableError(err) {
+			return fmt.Errorf("failed to get ManifestWork: %w", err)
+		}
+		if err == nil && appliedAtGeneration(details, generation, updatedAt) {
+			log.Info(ctx, "ManifestWork generation applied", logger.Fields{
+				"name":       workName,
+				"generation": generation,
+			})
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return notApplied()
+		case evt, ok := <-events:
+			if !ok {
+				events = nil
+				eventDriven = false
+				ticker.Reset(pollInterval)
+				continue
+			}
+			eventDriven = switchToResync(ctx, eventDriven, ticker, log)
+			if evt.Deleted {
+				return clierrors.NewNotFound("ManifestWork %s was deleted before generation %d was applied",
+					workName, generation)
+			}
+			details, err = evt.Details, nil
+		case <-ticker.C:
+			details, err = c.GetManifestWorkDetailsHTTP(ctx, consumer, workName)
+			if err != nil && IsRetryableError(err) {
+				log.Warn(ctx, "Failed to poll ManifestWork", logger.Fields{"error": err.Error()})
+			}
+		}
+	}
+}
+
+// appliedAtGeneration reports whether the Applied condition is True at the generation or a later one
+// Without an observed generation, an Applied condition set before updatedAt may still be the one of
+// an earlier generation, so the condition only counts once it transitioned in a later second
+// (lastTransitionTime has a resolution of one second).
+func appliedAtGeneration(details *ManifestWorkDetails, generation int64, updatedAt time.Time) bool {
+	for _, cond := range details.Conditions {
+		if !strings.EqualFold(cond.Type, "Applied") || cond.Status != statusTrue {
+			continue
+		}
+		if cond.ObservedGeneration > 0 {
+			return cond.ObservedGeneration >= generation
+		}
+		transitioned, err := time.Parse(time.RFC3339, cond.LastTransitionTime)
+		return err == nil && transitioned.After(updatedAt.Truncate(time.Second))
+	}
+	return false
+}
@@ -0,0 +1,189 @@
+package maestro
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+	workv1 "open-cluster-management.io/api/work/v1"
+
+	clierrors "github.com/openshift-hyperfleet/maestro-cli/pkg/errors"
+	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
+)
+
+func TestCheckManifestCount(t *testing.T) {
+	bundle := map[string]interface{}{
+		"id":            "3f1c2a9e-0000-0000-0000-000000000002",
+		"consumer_name": "agent1",
+		"version":       1,
+		"metadata":      map[string]interface{}{"name": "test-mw"},
+		"manifests": []interface{}{
+			map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata":   map[string]interface{}{"name": "a"},
+			},
+		},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		items := []interface{}{}
+		if !strings.Contains(r.URL.Query().Get("search"), "'missing-mw'") {
+			items = append(items, bundle)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"kind":  "ResourceBundleList",
+			"page":  1,
+			"size":  len(items),
+			"total": len(items),
+			"items": items,
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewHTTPClient(ClientConfig{HTTPEndpoint: server.URL})
+	if err != nil {
+		t.Fatalf("NewHTTPClient() unexpected error: %v", err)
+	}
+	ctx := context.Background()
+
+	manifest := workv1.Manifest{RawExtension: runtime.RawExtension{Raw: []byte(`{"kind":"ConfigMap"}`)}}
+	work := func(name string, manifests int) *workv1.ManifestWork {
+		mw := &workv1.ManifestWork{}
+		mw.Name = name
+		for i := 0; i < manifests; i++ {
+			mw.Spec.Workload.Manifests = append(mw.Spec.Workload.Manifests, manifest)
+		}
+		return mw
+	}
+
+	if err := client.CheckManifestCount(ctx, "agent1", work("test-mw", 1)); err != nil {
+		t.Errorf("same manifest count: unexpected error: %v", err)
+	}
+	if err := client.CheckManifestCount(ctx, "agent1", work("missing-mw", 3)); err != nil {
+		t.Errorf("missing work: unexpected error: %v", err)
+	}
+
+	err = client.CheckManifestCount(ctx, "agent1", work("test-mw", 2))
+	var countErr *ManifestCountChangedError
+	if !errors.As(err, &countErr) {
+		t.Fatalf("changed manifest count: expected ManifestCountChangedError, got %v", err)
+	}
+	if countErr.Existing != 1 || countErr.Desired != 2 {
+		t.Errorf("expected 1 existing and 2 desired manifests, got %d and %d", countErr.Existing, countErr.Desired)
+	}
+	if got := clierrors.FromError(err).Type; got != clierrors.TypeConflict {
+		t.Errorf("expected error type %s, got %s", clierrors.TypeConflict, got)
+	}
+}
+
+func TestRecreateManifestWorkWaitsForOrphan(t *testing.T) {
+	log := logger.New(logger.Config{Level: "error", Format: "text"})
+	resource := workv1.SchemeGroupVersion.WithResource("manifestworks")
+
+	tests := []struct {
+		name     string
+		observed int64  // observed generation of the Applied condition before the patch, 0 if not reported
+		applies  bool   // whether the agent applies the Orphan policy
+		steps    string // step:status of each step carried out
+		errorMsg string
+	}{
+		{
+			name:     "deleted once the Orphan policy is applied",
+			observed: 1,
+			applies:  true,
+			steps:    "Orphan:Succeeded WaitForOrphan:Succeeded Delete:Succeeded WaitForDeletion:Succeeded Create:Succeeded",
+		},
+		{
+			name:     "not deleted while the Orphan policy is not applied",
+			observed: 1,
+			steps:    "Orphan:Succeeded WaitForOrphan:Failed",
+			errorMsg: "failed waiting for the Orphan delete propagation policy to be applied",
+		},
+		{
+			name:    "deleted once Applied transitions after the patch without observed generation",
+			applies: true,
+			steps:   "Orphan:Succeeded WaitForOrphan:Succeeded Delete:Succeeded WaitForDeletion:Succeeded Create:Succeeded",
+		},
+		{
+			name:     "not deleted on an old Applied condition without observed generation",
+			steps:    "Orphan:Succeeded WaitForOrphan:Failed",
+			errorMsg: "the Applied condition was not reported for generation 2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, fakeClient := newFakeMaestro(t)
+			stored := testWork("test-mw", "old")
+			stored.Status.Conditions = []metav1.Condition{{Type: "Applied", Status: metav1.ConditionTrue,
+				ObservedGeneration: tt.observed, LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Hour))}}
+			if err := fakeClient.Tracker().Create(resource, stored, "agent1"); err != nil {
+				t.Fatalf("failed to store work: %v", err)
+			}
+			var applied, deletedBeforeApplied atomic.Bool
+			fakeClient.PrependReactor("delete", "manifestworks", func(clienttesting.Action) (bool, runtime.Object, error) {
+				deletedBeforeApplied.Store(!applied.Load())
+				return false, nil, nil
+			})
+			ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+			defer cancel()
+
+			// The agent reports the patched generation Applied a few polls after the patch, either
+			// observed at that generation or, without observed generations, with a later transition
+			if tt.applies {
+				go func() {
+					for ctx.Err() == nil {
+						obj, err := fakeClient.Tracker().Get(resource, "agent1", "test-mw")
+						if work, ok := obj.(*workv1.ManifestWork); err == nil && ok && work.Generation == 2 {
+							time.Sleep(50 * time.Millisecond)
+							work = work.DeepCopy()
+							if tt.observed > 0 {
+								work.Status.Conditions[0].ObservedGeneration = 2
+							} else {
+								work.Status.Conditions[0].LastTransitionTime = metav1.NewTime(time.Now().Add(time.Second))
+							}
+							applied.Store(true)
+							_ = fakeClient.Tracker().Update(resource, work, "agent1")
+							return
+						}
+						time.Sleep(5 * time.Millisecond)
+					}
+				}()
+			}
+
+			desired := testWork("test-mw", "new")
+			desired.Spec.Workload.Manifests = append(desired.Spec.Workload.Manifests, stored.Spec.Workload.Manifests...)
+			_, steps, err := client.RecreateManifestWork(ctx, "agent1", desired, 10*time.Millisecond, log)
+			if tt.errorMsg == "" && err != nil {
+				t.Fatalf("RecreateManifestWork() unexpected error: %v", err)
+			}
+			if tt.errorMsg != "" && (err == nil || !strings.Contains(err.Error(), tt.errorMsg)) {
+				t.Fatalf("expected error containing %q, got %v", tt.errorMsg, err)
+			}
+			var got []string
+			for _, s := range steps {
+				got = append(got, s.Step+":"+s.Status)
+			}
+			if strings.Join(got, " ") != tt.steps {
+				t.Errorf("steps = %v, expected %s", got, tt.steps)
+			}
+			if deletedBeforeApplied.Load() {
+				t.Error("the work was deleted before the agent applied the Orphan policy")
+			}
+			for _, action := range fakeClient.Actions() {
+				if !tt.applies && action.GetVerb() == "delete" {
+					t.Error("the work was deleted although the Orphan policy was never applied")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,273 @@
+package maestro
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clierrors "github.com/openshift-hyperfleet/maestro-cli/pkg/errors"
+	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
+)
+
+const (
+	// DefaultRetryMaxAttempts is the default number of attempts per Maestro API call (including the first)
+	DefaultRetryMaxAttempts = 4
+
+	// DefaultRetryInitialBackoff is the default delay before the first retry
+	DefaultRetryInitialBackoff = 500 * time.Millisecond
+
+	// DefaultRetryMaxBackoff is the default upper bound for the delay between retries
+	DefaultRetryMaxBackoff = 10 * time.Second
+
+	// retryJitter is the fraction of each backoff delay that is randomized
+	retryJitter = 0.2
+)
+
+// RetryPolicy controls how Maestro API calls are retried on transient failures
+// Delays grow exponentially from InitialBackoff and are capped at MaxBackoff, with random jitter.
+// Zero values are replaced by the defaults; MaxAttempts of 1 disables retries.
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// DefaultRetryPolicy returns the retry policy used when none is configured
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    DefaultRetryMaxAttempts,
+		InitialBackoff: DefaultRetryInitialBackoff,
+		MaxBackoff:     DefaultRetryMaxBackoff,
+	}
+}
+
+// withDefaults fills unset fields of the policy with the defaults
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	defaults := DefaultRetryPolicy()
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = defaults.MaxAttempts
+	}
+	if p.InitialBackoff <= 0 {
+		p.InitialBackoff = defaults.InitialBackoff
+	}
+	if p.MaxBackoff <= 0 {
+		p.MaxBackoff = defaults.MaxBackoff
+	}
+	if p.MaxBackoff < p.InitialBackoff {
+		p.MaxBackoff = p.InitialBackoff
+	}
+	return p
+}
+
+// Backoff returns the delay before the given retry (1 for the first retry)
+func (p RetryPolicy) Backoff(retry int) time.Duration {
+	p = p.withDefaults()
+
+	delay := p.InitialBackoff
+	for i := 1; i < retry && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+
+	// Spread retries of concurrent callers: +/- retryJitter of the delay
+	jitter := (rand.Float64()*2 - 1) * retryJitter * float64(delay) //nolint:gosec // jitter does not need crypto rand
+	return delay + time.Duration(jitter)
+}
+
+// Do calls fn until it succeeds, returns a terminal error or the attempts are exhausted
+func (p RetryPolicy) Do(ctx context.Context, fn func() error) error {
+	p = p.withDefaults()
+
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || attempt >= p.MaxAttempts || !IsRetryableError(err) {
+			return err
+		}
+
+		timer := time.NewTimer(p.Backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// APIError is an error returned by a Maestro HTTP API call
+// StatusCode is the HTTP status of the response, or 0 when no response was received
+type APIError struct {
+	Op         string
+	StatusCode int
+	Err        error
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Op, e.Err)
+}
+
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// HTTPStatus returns the HTTP status code of the response, or 0 when no response was received
+func (e *APIError) HTTPStatus() int {
+	return e.StatusCode
+}
+
+// newAPIError wraps an error of a Maestro HTTP API call with the response status code
+func newAPIError(op string, resp *http.Response, err error) error {
+	apiErr := &APIError{Op: op, Err: err}
+	if resp != nil {
+		apiErr.StatusCode = resp.StatusCode
+	}
+	return apiErr
+}
+
+// IsRetryableError reports whether a failed Maestro API call may succeed when retried.
+// Timeouts, connection failures, 429 and 5xx responses are retryable; client errors
+// such as 400, 401, 403, 404 and 409, invalid conditions and cancelled contexts are terminal.
+func IsRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var syntaxErr *ConditionSyntaxError
+	if errors.As(err, &syntaxErr) {
+		return false
+	}
+
+	// Already classified errors (e.g. a missing consumer) are terminal unless the server failed
+	var cliErr *clierrors.Error
+	if errors.As(err, &cliErr) {
+		return cliErr.Type == clierrors.TypeServerError
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode != 0 {
+		return isRetryableStatus(apiErr.StatusCode)
+	}
+
+	var statusErr apierrors.APIStatus
+	if errors.As(err, &statusErr) {
+		switch apierrors.ReasonForError(err) {
+		case metav1.StatusReasonTimeout, metav1.StatusReasonServerTimeout, metav1.StatusReasonTooManyRequests,
+			metav1.StatusReasonInternalError, metav1.StatusReasonServiceUnavailable:
+			return true
+		}
+		if code := statusErr.Status().Code; code != 0 {
+			return isRetryableStatus(int(code))
+		}
+		return false
+	}
+
+	// No response from the server (connection refused/reset, DNS, TLS handshake timeout, ...)
+	return true
+}
+
+// isRetryableStatus reports whether an HTTP status code indicates a transient failure
+func isRetryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests ||
+		code == http.StatusRequestTimeout ||
+		(code >= http.StatusInternalServerError && code != http.StatusNotImplemented)
+}
+
+// retryTransport retries idempotent HTTP requests that fail with a transient error
+type retryTransport struct {
+	base   http.RoundTripper
+	policy RetryPolicy
+	log    *logger.Logger
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isIdempotent(req.Method) || (req.Body != nil && req.Body != http.NoBody && req.GetBody == nil) {
+		return t.base.RoundTrip(req)
+	}
+
+	ctx := req.Context()
+	for attempt := 1; ; attempt++ {
+		attemptReq := req
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(ctx)
+			attemptReq.Body = body
+		}
+
+		resp, err := t.base.RoundTrip(attemptReq)
+		if attempt >= t.policy.MaxAttempts || ctx.Err() != nil || !shouldRetryResponse(resp, err) {
+			return resp, err
+		}
+
+		delay := t.policy.Backoff(attempt)
+		fields := logger.Fields{
+			"method":  req.Method,
+			"path":    req.URL.Path,
+			"attempt": attempt,
+			"delay":   delay.String(),
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status"] = resp.StatusCode
+			if retryAfter := parseRetryAfter(resp); retryAfter > delay && retryAfter <= t.policy.MaxBackoff {
+				delay = retryAfter
+				fields["delay"] = delay.String()
+			}
+			// Drain the body so the connection can be reused
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+		t.log.Debug(ctx, "Retrying Maestro API request", fields)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// shouldRetryResponse reports whether a round trip failed with a transient error
+func shouldRetryResponse(resp *http.Response, err error) bool {
+	if err != nil {
+		return IsRetryableError(err)
+	}
+	return isRetryableStatus(resp.StatusCode)
+}
+
+// isIdempotent reports whether requests with the given method can safely be sent again
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodDelete, http.MethodPut:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter returns the delay requested by a Retry-After header in seconds, or 0
+func parseRetryAfter(resp *http.Response) time.Duration {
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
@@ -0,0 +1,197 @@
+package maestro
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+	workfake "open-cluster-management.io/api/client/work/clientset/versioned/fake"
+	workv1 "open-cluster-management.io/api/work/v1"
+
+	clierrors "github.com/openshift-hyperfleet/maestro-cli/pkg/errors"
+)
+
+func TestIsRetryableError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "nil", err: nil, expected: false},
+		{name: "connection reset", err: errors.New("read: connection reset by peer"), expected: true},
+		{name: "HTTP 429", err: &APIError{Op: "list", StatusCode: http.StatusTooManyRequests}, expected: true},
+		{name: "HTTP 503", err: &APIError{Op: "list", StatusCode: http.StatusServiceUnavailable}, expected: true},
+		{name: "HTTP 400", err: &APIError{Op: "list", StatusCode: http.StatusBadRequest}, expected: false},
+		{name: "HTTP 401", err: &APIError{Op: "list", StatusCode: http.StatusUnauthorized}, expected: false},
+		{name: "HTTP 403", err: &APIError{Op: "list", StatusCode: http.StatusForbidden}, expected: false},
+		{name: "HTTP 404", err: &APIError{Op: "list", StatusCode: http.StatusNotFound}, expected: false},
+		{
+			name:     "wrapped API error",
+			err:      fmt.Errorf("failed: %w", &APIError{Op: "get", StatusCode: http.StatusBadGateway}),
+			expected: true,
+		},
+		{
+			name:     "kubernetes not found",
+			err:      apierrors.NewNotFound(workv1.Resource("manifestwork"), "test"),
+			expected: false,
+		},
+		{
+			name:     "kubernetes server timeout",
+			err:      apierrors.NewServerTimeout(workv1.Resource("manifestwork"), "get", 1),
+			expected: true,
+		},
+		{name: "context cancelled", err: context.Canceled, expected: false},
+		{name: "condition syntax", err: &ConditionSyntaxError{Expr: "A AND", Column: 6}, expected: false},
+		{name: "classified not found", err: clierrors.NewNotFound("consumer 'c1' not found"), expected: false},
+		{name: "classified server error", err: clierrors.New(clierrors.TypeServerError, "boom"), expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetryableError(tt.err); got != tt.expected {
+				t.Errorf("IsRetryableError(%v) = %v, expected %v", tt.err, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 5, InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	tests := []struct {
+		retry int
+		base  time.Duration
+	}{
+		{retry: 1, base: 100 * time.Millisecond},
+		{retry: 2, base: 200 * time.Millisecond},
+		{retry: 3, base: 400 * time.Millisecond},
+		{retry: 5, base: time.Second}, // capped
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("retry %d", tt.retry), func(t *testing.T) {
+			delay := policy.Backoff(tt.retry)
+			jitter := time.Duration(retryJitter * float64(tt.base))
+			if delay < tt.base-jitter || delay > tt.base+jitter {
+				t.Errorf("Backoff(%d) = %v, expected %v +/- %v", tt.retry, delay, tt.base, jitter)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyDo(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+
+	attempts := 0
+	err := policy.Do(context.Background(), func() error {
+		attempts++
+		return errors.New("connection refused")
+	})
+	if err == nil || attempts != 3 {
+		t.Errorf("expected 3 attempts ending in error, got %d attempts, err=%v", attempts, err)
+	}
+
+	attempts = 0
+	err = policy.Do(context.Background(), func() error {
+		attempts++
+		return apierrors.NewNotFound(workv1.Resource("manifestwork"), "test")
+	})
+	if !apierrors.IsNotFound(err) || attempts != 1 {
+		t.Errorf("expected terminal error after 1 attempt, got %d attempts, err=%v", attempts, err)
+	}
+}
+
+func TestHTTPClientRetriesTransientFailures(t *testing.T) {
+	failures := 2
+	requests := 0
+	forbidden := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		if forbidden {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if requests <= failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"kind": "ConsumerList", "page": 1, "size": 0, "total": 0, "items": []interface{}{},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewHTTPClient(ClientConfig{
+		HTTPEndpoint: server.URL,
+		Retry:        RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond},
+	})
+	if err != nil {
+		t.Fatalf("NewHTTPClient() unexpected error: %v", err)
+	}
+
+	if _, err := client.ListConsumers(context.Background()); err != nil {
+		t.Fatalf("ListConsumers() expected success after retries, got %v", err)
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 requests, got %d", requests)
+	}
+
+	// Terminal status codes are returned after a single request
+	requests = 0
+	forbidden = true
+	_, err = client.ListConsumers(context.Background())
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected APIError with status 403, got %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("expected 1 request for a terminal error, got %d", requests)
+	}
+}
+
+func TestCreateManifestWorkRetryAlreadyExists(t *testing.T) {
+	fakeClient := workfake.NewSimpleClientset()
+	creates := 0
+	// The first attempt publishes the work but fails; the retry then finds it already exists
+	fakeClient.PrependReactor("create", "manifestworks", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		creates++
+		if creates > 1 {
+			return false, nil, nil
+		}
+		work := action.(clienttesting.CreateAction).GetObject()
+		if err := fakeClient.Tracker().Create(workv1.SchemeGroupVersion.WithResource("manifestworks"), work,
+			action.GetNamespace()); err != nil {
+			t.Fatalf("failed to store work: %v", err)
+		}
+		return true, nil, apierrors.NewServiceUnavailable("connection lost")
+	})
+	client := &Client{
+		workClient: fakeClient.WorkV1(),
+		retry:      RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond},
+	}
+
+	desired := &workv1.ManifestWork{}
+	desired.Name = "test-mw"
+	created, err := client.createManifestWork(context.Background(), "agent1", desired)
+	if err != nil {
+		t.Fatalf("createManifestWork() unexpected error: %v", err)
+	}
+	if created == nil || created.Name != "test-mw" || creates != 2 {
+		t.Errorf("expected the published work after 2 attempts, got %v after %d", created, creates)
+	}
+
+	// Without an earlier attempt, an existing work is still a conflict
+	_, err = client.createManifestWork(context.Background(), "agent1", desired)
+	if !apierrors.IsAlreadyExists(err) {
+		t.Errorf("expected AlreadyExists on a first attempt, got %v", err)
+	}
+}
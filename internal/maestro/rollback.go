@@ -0,0 +1,106 @@
+package maestro
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
+)
+
+// Steps of a rollback
+const (
+	RollbackStepDelete          = "Delete"
+	RollbackStepWaitForDeletion = "WaitForDeletion"
+	RollbackStepRestore         = "Restore"
+	RollbackStepWait            = "WaitForApplied"
+)
+
+// RollbackManifestWork undoes an apply, e.g. after its wait condition failed
+// A created work is deleted together with its resources and its removal awaited. An updated
+// work gets back the labels, annotations and spec it had before the update; a recreated work
+// is recreated from them, so resources only added by the failed spec are left orphaned. Both
+// are then awaited until Applied. Unchanged works need no rollback.
+// The steps carried out are returned even when one of them fails.
+func (c *Client) RollbackManifestWork(
+	ctx context.Context,
+	consumer string,
+	applied *ApplyResult,
+	pollInterval time.Duration,
+	log *logger.Logger,
+) ([]Step, error) {
+	if applied.Operation == ApplyOperationUnchanged {
+		return nil, nil
+	}
+
+	name := applied.Name
+	log.Info(ctx, "Rolling back ManifestWork", logger.Fields{
+		"manifest_name": name,
+		"consumer":      consumer,
+		"operation":     applied.Operation,
+	})
+
+	var steps []Step
+	if applied.Operation == ApplyOperationCreated {
+		err := c.retry.Do(ctx, func() error {
+			err := c.DeleteManifestWork(ctx, consumer, name)
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		})
+		steps = append(steps, NewStep(RollbackStepDelete, "created ManifestWork deleted", err))
+		if err != nil {
+			return steps, fmt.Errorf("failed to delete ManifestWork: %w", err)
+		}
+
+		err = c.WaitForDeletion(ctx, consumer, name, pollInterval, log)
+		steps = append(steps, NewStep(RollbackStepWaitForDeletion, "ManifestWork removed", err))
+		if err != nil {
+			return steps, fmt.Errorf("failed waiting for ManifestWork deletion: %w", err)
+		}
+		return steps, nil
+	}
+
+	if applied.Previous == nil {
+		err := fmt.Errorf("the previous spec of ManifestWork %s is unknown", name)
+		return append(steps, NewStep(RollbackStepRestore, "", err)), err
+	}
+	previous := applied.Previous.DeepCopy()
+
+	if applied.Operation == ApplyOperationRecreated {
+		// The steps of the recreate (Orphan, Delete, WaitForDeletion, Create) are reported as is
+		_, recreateSteps, err := c.RecreateManifestWork(ctx, consumer, previous, pollInterval, log)
+		steps = append(steps, recreateSteps...)
+		if err != nil {
+			return steps, fmt.Errorf("failed to recreate previous ManifestWork: %w", err)
+		}
+	} else {
+		err := c.retry.Do(ctx, func() error {
+			current, err := c.GetManifestWork(ctx, consumer, name)
+			if err != nil {
+				return err
+			}
+			restored := current.DeepCopy()
+			restored.Labels = previous.Labels
+			restored.Annotations = previous.Annotations
+			restored.Spec = previous.Spec
+			_, err = c.PatchManifestWork(ctx, consumer, current, restored, log)
+			return err
+		})
+		steps = append(steps, NewStep(RollbackStepRestore,
+			fmt.Sprintf("previous spec restored (generation %d)", previous.Generation), err))
+		if err != nil {
+			return steps, fmt.Errorf("failed to restore previous spec: %w", err)
+		}
+	}
+
+	err := c.WaitForCondition(ctx, consumer, name, "Applied", pollInterval, log, nil)
+	steps = append(steps, NewStep(RollbackStepWait, "previous spec applied", err))
+	if err != nil {
+		return steps, fmt.Errorf("failed waiting for the previous spec to be applied: %w", err)
+	}
+	return steps, nil
+}
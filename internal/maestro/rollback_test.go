@@ -0,0 +1,242 @@
+package maestro
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+	workfake "open-cluster-management.io/api/client/work/clientset/versioned/fake"
+	workv1 "open-cluster-management.io/api/work/v1"
+
+	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
+)
+
+// newFakeMaestro returns a client whose gRPC work client is a fake clientset and whose HTTP API
+// serves the works stored in that clientset as resource bundles. As in Maestro, a patch increments
+// the generation of a work. Works without status conditions are reported Applied at their generation.
+func newFakeMaestro(t *testing.T) (*Client, *workfake.Clientset) {
+	t.Helper()
+	resource := workv1.SchemeGroupVersion.WithResource("manifestworks")
+	fakeClient := workfake.NewSimpleClientset()
+	patch := clienttesting.ObjectReaction(fakeClient.Tracker())
+	fakeClient.PrependReactor("patch", "manifestworks", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		_, obj, err := patch(action)
+		if err != nil {
+			return true, nil, err
+		}
+		work := obj.(*workv1.ManifestWork).DeepCopy()
+		work.Generation++
+		return true, work, fakeClient.Tracker().Update(resource, work, action.GetNamespace())
+	})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		works, err := fakeClient.Tracker().List(resource, workv1.SchemeGroupVersion.WithKind("ManifestWork"), "")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		items := []interface{}{}
+		for _, work := range works.(*workv1.ManifestWorkList).Items {
+			manifests := []interface{}{}
+			for _, m := range work.Spec.Workload.Manifests {
+				manifests = append(manifests, json.RawMessage(m.Raw))
+			}
+			conditions := work.Status.Conditions
+			if len(conditions) == 0 {
+				conditions = []metav1.Condition{{Type: "Applied", Status: metav1.ConditionTrue,
+					ObservedGeneration: work.Generation, LastTransitionTime: metav1.Now()}}
+			}
+			items = append(items, map[string]interface{}{
+				"consumer_name": work.Namespace,
+				"version":       work.Generation,
+				"metadata": map[string]interface{}{
+					"name":        work.Name,
+					"labels":      work.Labels,
+					"annotations": work.Annotations,
+				},
+				"manifests":     manifests,
+				"delete_option": work.Spec.DeleteOption,
+				"status":        map[string]interface{}{"conditions": conditions},
+			})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"kind":  "ResourceBundleList",
+			"page":  1,
+			"size":  len(items),
+			"total": len(items),
+			"items": items,
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := NewHTTPClient(ClientConfig{HTTPEndpoint: server.URL})
+	if err != nil {
+		t.Fatalf("NewHTTPClient() unexpected error: %v", err)
+	}
+	client.workClient = fakeClient.WorkV1()
+	client.retry = RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+	return client, fakeClient
+}
+
+// testWork returns a ManifestWork of consumer agent1 at generation 1 with one ConfigMap holding the given value
+func testWork(name, value string) *workv1.ManifestWork {
+	work := &workv1.ManifestWork{}
+	work.Name = name
+	work.Namespace = "agent1"
+	work.Generation = 1
+	work.Labels = map[string]string{"value": value}
+	work.Spec.Workload.Manifests = []workv1.Manifest{{RawExtension: runtime.RawExtension{
+		Raw: []byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"cm"},"data":{"value":"` + value + `"}}`),
+	}}}
+	return work
+}
+
+func TestRollbackManifestWork(t *testing.T) {
+	log := logger.New(logger.Config{Level: "error", Format: "text"})
+	resource := workv1.SchemeGroupVersion.WithResource("manifestworks")
+
+	tests := []struct {
+		name     string
+		stored   *workv1.ManifestWork // work in Maestro after the apply
+		applied  *ApplyResult
+		steps    string // step:status of each step carried out
+		value    string // value label of the work in Maestro after the rollback, empty if it was deleted
+		errorMsg string
+	}{
+		{
+			name:    "created work is deleted",
+			stored:  testWork("test-mw", "new"),
+			applied: &ApplyResult{ManifestWork: testWork("test-mw", "new"), Operation: ApplyOperationCreated},
+			steps:   "Delete:Succeeded WaitForDeletion:Succeeded",
+		},
+		{
+			name:   "updated work gets its previous spec back",
+			stored: testWork("test-mw", "new"),
+			applied: &ApplyResult{
+				ManifestWork: testWork("test-mw", "new"),
+				Operation:    ApplyOperationUpdated,
+				Previous:     testWork("test-mw", "old"),
+			},
+			steps: "Restore:Succeeded WaitForApplied:Succeeded",
+			value: "old",
+		},
+		{
+			name:   "recreated work is recreated from its previous spec",
+			stored: testWork("test-mw", "new"),
+			applied: &ApplyResult{
+				ManifestWork: testWork("test-mw", "new"),
+				Operation:    ApplyOperationRecreated,
+				Previous:     testWork("test-mw", "old"),
+			},
+			steps: "Orphan:Succeeded WaitForOrphan:Succeeded Delete:Succeeded WaitForDeletion:Succeeded " +
+				"Create:Succeeded WaitForApplied:Succeeded",
+			value: "old",
+		},
+		{
+			name:    "unchanged work needs no rollback",
+			stored:  testWork("test-mw", "same"),
+			applied: &ApplyResult{ManifestWork: testWork("test-mw", "same"), Operation: ApplyOperationUnchanged},
+			value:   "same",
+		},
+		{
+			name:     "updated work without previous spec",
+			stored:   testWork("test-mw", "new"),
+			applied:  &ApplyResult{ManifestWork: testWork("test-mw", "new"), Operation: ApplyOperationUpdated},
+			steps:    "Restore:Failed",
+			value:    "new",
+			errorMsg: "previous spec of ManifestWork test-mw is unknown",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, fakeClient := newFakeMaestro(t)
+			if err := fakeClient.Tracker().Create(resource, tt.stored, "agent1"); err != nil {
+				t.Fatalf("failed to store work: %v", err)
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			steps, err := client.RollbackManifestWork(ctx, "agent1", tt.applied, 10*time.Millisecond, log)
+			if tt.errorMsg == "" && err != nil {
+				t.Fatalf("RollbackManifestWork() unexpected error: %v", err)
+			}
+			if tt.errorMsg != "" && (err == nil || !strings.Contains(err.Error(), tt.errorMsg)) {
+				t.Fatalf("expected error containing %q, got %v", tt.errorMsg, err)
+			}
+			var got []string
+			for _, s := range steps {
+				got = append(got, s.Step+":"+s.Status)
+			}
+			if strings.Join(got, " ") != tt.steps {
+				t.Errorf("steps = %v, expected %s", got, tt.steps)
+			}
+
+			work, err := fakeClient.WorkV1().ManifestWorks("agent1").Get(ctx, "test-mw", metav1.GetOptions{})
+			switch {
+			case tt.value == "" && !apierrors.IsNotFound(err):
+				t.Errorf("expected the work to be deleted, got %v", err)
+			case tt.value != "" && err != nil:
+				t.Errorf("expected the work to exist: %v", err)
+			case tt.value != "" && work.Labels["value"] != tt.value:
+				t.Errorf("expected value %q after the rollback, got %q", tt.value, work.Labels["value"])
+			}
+		})
+	}
+}
+
+func TestApplyManifestWorkReplacesUnsubscribedWork(t *testing.T) {
+	log := logger.New(logger.Config{Level: "error", Format: "text"})
+	client, fakeClient := newFakeMaestro(t)
+	stored := testWork("test-mw", "old")
+	stored.Generation = 3
+	if err := fakeClient.Tracker().Create(workv1.SchemeGroupVersion.WithResource("manifestworks"), stored,
+		"agent1"); err != nil {
+		t.Fatalf("failed to store work: %v", err)
+	}
+	// The work is in the database but not in the subscription, so the gRPC client neither gets
+	// it nor rejects its creation
+	subscribed := false
+	fakeClient.PrependReactor("get", "manifestworks", func(clienttesting.Action) (bool, runtime.Object, error) {
+		if subscribed {
+			return false, nil, nil
+		}
+		return true, nil, apierrors.NewNotFound(workv1.Resource("manifestworks"), "test-mw")
+	})
+	fakeClient.PrependReactor("create", "manifestworks", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		work := action.(clienttesting.CreateAction).GetObject().(*workv1.ManifestWork).DeepCopy()
+		work.Generation = 4
+		return true, work, fakeClient.Tracker().Update(workv1.SchemeGroupVersion.WithResource("manifestworks"),
+			work, "agent1")
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	applied, err := client.ApplyManifestWork(ctx, "agent1", testWork("test-mw", "new"), log)
+	if err != nil {
+		t.Fatalf("ApplyManifestWork() unexpected error: %v", err)
+	}
+	if applied.Operation != ApplyOperationUpdated || applied.Previous == nil {
+		t.Fatalf("expected an update with the previous work, got %s with %v", applied.Operation, applied.Previous)
+	}
+	if applied.Previous.Labels["value"] != "old" || applied.Previous.Generation != 3 ||
+		len(applied.Previous.Spec.Workload.Manifests) != 1 {
+		t.Errorf("expected the stored work at generation 3 as previous, got %+v", applied.Previous)
+	}
+
+	subscribed = true
+	if _, err := client.RollbackManifestWork(ctx, "agent1", applied, 10*time.Millisecond, log); err != nil {
+		t.Fatalf("RollbackManifestWork() unexpected error: %v", err)
+	}
+	work, err := fakeClient.WorkV1().ManifestWorks("agent1").Get(ctx, "test-mw", metav1.GetOptions{})
+	if err != nil || work.Labels["value"] != "old" {
+		t.Errorf("expected the previous work to be restored, got %v (%v)", work, err)
+	}
+}
@@ -0,0 +1,61 @@
+package maestro
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+
+	workv1 "open-cluster-management.io/api/work/v1"
+)
+
+// SpecHashAnnotation records the hash of the spec last applied by maestro-cli
+const SpecHashAnnotation = "maestro-cli.hyperfleet.io/spec-hash"
+
+// SpecHash returns a canonical hash of the manifests, manifestConfigs and deleteOption of a spec
+// Manifests are hashed as JSON values, so formatting and key order do not change the hash.
+func SpecHash(spec workv1.ManifestWorkSpec) (string, error) {
+	normalized, err := normalizedJSON(struct {
+		Manifests       []workv1.Manifest             `json:"manifests,omitempty"`
+		ManifestConfigs []workv1.ManifestConfigOption `json:"manifestConfigs,omitempty"`
+		DeleteOption    *workv1.DeleteOption          `json:"deleteOption,omitempty"`
+	}{spec.Workload.Manifests, spec.ManifestConfigs, spec.DeleteOption})
+	if err != nil {
+		return "", fmt.Errorf("failed to normalize spec: %w", err)
+	}
+
+	// Maps are encoded with sorted keys, which makes the encoding canonical
+	data, err := json.Marshal(normalized)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode spec: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:]), nil
+}
+
+// setSpecHash stores the hash of the spec of a ManifestWork in its SpecHashAnnotation
+func setSpecHash(manifestWork *workv1.ManifestWork) error {
+	hash, err := SpecHash(manifestWork.Spec)
+	if err != nil {
+		return err
+	}
+	// Copy the annotations, which may be shared with the caller's objects
+	annotations := maps.Clone(manifestWork.Annotations)
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[SpecHashAnnotation] = hash
+	manifestWork.Annotations = annotations
+	return nil
+}
+
+// withoutSpecHash returns annotations without the SpecHashAnnotation
+func withoutSpecHash(annotations map[string]string) map[string]string {
+	if _, ok := annotations[SpecHashAnnotation]; !ok {
+		return annotations
+	}
+	stripped := maps.Clone(annotations)
+	delete(stripped, SpecHashAnnotation)
+	return stripped
+}
@@ -0,0 +1,62 @@
+package maestro
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	workv1 "open-cluster-management.io/api/work/v1"
+)
+
+func TestSpecHash(t *testing.T) {
+	spec := func(
+		manifest string,
+		configs []workv1.ManifestConfigOption,
+		deleteOption *workv1.DeleteOption,
+	) workv1.ManifestWorkSpec {
+		s := workv1.ManifestWorkSpec{ManifestConfigs: configs, DeleteOption: deleteOption}
+		s.Workload.Manifests = []workv1.Manifest{{RawExtension: runtime.RawExtension{Raw: []byte(manifest)}}}
+		return s
+	}
+	configMap := `{"kind":"ConfigMap","metadata":{"name":"config"}}`
+	configs := []workv1.ManifestConfigOption{{
+		ResourceIdentifier: workv1.ResourceIdentifier{Resource: "configmaps", Name: "config"},
+		UpdateStrategy:     &workv1.UpdateStrategy{Type: workv1.UpdateStrategyTypeServerSideApply},
+	}}
+	orphan := &workv1.DeleteOption{PropagationPolicy: workv1.DeletePropagationPolicyTypeOrphan}
+
+	base, err := SpecHash(spec(configMap, nil, nil))
+	if err != nil {
+		t.Fatalf("SpecHash() unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(base, "sha256:") {
+		t.Errorf("expected a sha256: hash, got %q", base)
+	}
+
+	tests := []struct {
+		name string
+		spec workv1.ManifestWorkSpec
+		same bool
+	}{
+		{
+			name: "key order and whitespace",
+			spec: spec(`{ "metadata": {"name": "config"}, "kind": "ConfigMap" }`, nil, nil),
+			same: true,
+		},
+		{name: "empty manifest configs", spec: spec(configMap, []workv1.ManifestConfigOption{}, nil), same: true},
+		{name: "manifest", spec: spec(`{"kind":"ConfigMap","metadata":{"name":"other"}}`, nil, nil)},
+		{name: "manifest configs", spec: spec(configMap, configs, nil)},
+		{name: "delete option", spec: spec(configMap, nil, orphan)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash, err := SpecHash(tt.spec)
+			if err != nil {
+				t.Fatalf("SpecHash() unexpected error: %v", err)
+			}
+			if (hash == base) != tt.same {
+				t.Errorf("SpecHash() = %q, base %q, expected same = %v", hash, base, tt.same)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package maestro
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
+)
+
+// stableNode is a stability window: it is true once its operand has held continuously for duration
+// The window starts at the first evaluation where the operand holds, or earlier at the
+// lastTransitionTime of a ManifestWork condition, and is reset whenever the operand is false.
+type stableNode struct {
+	operand  conditionNode
+	duration time.Duration
+	text     string // duration as written in the expression
+
+	since time.Time // start of the current window, zero while the operand does not hold
+	now   time.Time // time of the last observation
+}
+
+// observe updates the window with the operand evaluated against details at time now
+func (n *stableNode) observe(ctx context.Context, details *ManifestWorkDetails, log *logger.Logger, now time.Time) {
+	n.now = now
+	if !n.operand.eval(ctx, details, log) {
+		n.since = time.Time{}
+		return
+	}
+
+	start := n.since
+	if start.IsZero() {
+		start = now
+	}
+	// A transition after the window started means the operand was false in between two polls
+	if transitioned, ok := lastTransition(n.operand, details); ok && (n.since.IsZero() || transitioned.After(n.since)) {
+		start = transitioned
+	}
+	if start.After(now) {
+		start = now
+	}
+	n.since = start
+}
+
+// held returns how long the operand has held at the last observation
+func (n *stableNode) held() time.Duration {
+	if n.since.IsZero() {
+		return 0
+	}
+	return n.now.Sub(n.since)
+}
+
+func (n *stableNode) eval(_ context.Context, _ *ManifestWorkDetails, _ *logger.Logger) bool {
+	return !n.since.IsZero() && n.held() >= n.duration
+}
+
+func (n *stableNode) trace(ctx context.Context, details *ManifestWorkDetails, log *logger.Logger) *ConditionTrace {
+	operand := n.operand.trace(ctx, details, log)
+	trace := &ConditionTrace{
+		Expr:     n.String(),
+		Result:   n.eval(ctx, details, log),
+		Operands: []*ConditionTrace{operand},
+	}
+	if !n.since.IsZero() {
+		trace.Reason = fmt.Sprintf("held for %s of %s", n.held().Round(time.Second), n.text)
+	}
+	return trace
+}
+
+func (n *stableNode) String() string {
+	return fmt.Sprintf("%s for %s", n.operand, n.text)
+}
+
+// lastTransition returns the lastTransitionTime of the ManifestWork condition of node in its awaited status
+// Other expressions have no transition time, so their windows are measured from observations only.
+func lastTransition(node conditionNode, details *ManifestWorkDetails) (time.Time, bool) {
+	work, ok := node.(*workConditionNode)
+	if !ok {
+		return time.Time{}, false
+	}
+	for _, cond := range details.Conditions {
+		if strings.EqualFold(cond.Type, work.condType) && strings.EqualFold(cond.Status, work.wantedStatus()) {
+			transitioned, err := time.Parse(time.RFC3339, cond.LastTransitionTime)
+			return transitioned, err == nil
+		}
+	}
+	return time.Time{}, false
+}
+
+// observe updates the stability windows of the expression, innermost first, with details at time now
+func (c *Condition) observe(ctx context.Context, details *ManifestWorkDetails, log *logger.Logger, now time.Time) {
+	for _, window := range c.windows {
+		window.observe(ctx, details, log, now)
+	}
+}
+
+// stableFor wraps the whole expression in a stability window of duration (--stable-for)
+func (c *Condition) stableFor(duration time.Duration) {
+	window := &stableNode{operand: c.root, duration: duration, text: duration.String()}
+	c.root = window
+	c.windows = append(c.windows, window)
+	c.expr = fmt.Sprintf("(%s) for %s", c.expr, window.text)
+}
+
+// pendingUntil returns when the earliest running stability window completes, or zero if none is running
+// A window can complete without a new status, so waits evaluate the last status again at that time.
+func (c *Condition) pendingUntil() time.Time {
+	var until time.Time
+	if c == nil {
+		return until
+	}
+	for _, window := range c.windows {
+		if window.since.IsZero() || window.held() >= window.duration {
+			continue
+		}
+		if end := window.since.Add(window.duration); until.IsZero() || end.Before(until) {
+			until = end
+		}
+	}
+	return until
+}
+
+// earliest returns the earlier of two times, ignoring zero times
+func earliest(a, b time.Time) time.Time {
+	if a.IsZero() || (!b.IsZero() && b.Before(a)) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,114 @@
+package maestro
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
+)
+
+func TestStabilityWindow(t *testing.T) {
+	t0 := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	available := func(status, transitioned string) *ManifestWorkDetails {
+		return &ManifestWorkDetails{Conditions: []ConditionSummary{
+			{Type: "Available", Status: status, LastTransitionTime: transitioned},
+		}}
+	}
+
+	type observation struct {
+		at      time.Duration // since t0
+		details *ManifestWorkDetails
+		want    bool
+	}
+	tests := []struct {
+		name         string
+		expr         string
+		observations []observation
+	}{
+		{
+			name: "window measured from observations and reset by a flip",
+			expr: "Available for 30s",
+			observations: []observation{
+				{at: 0, details: available("True", ""), want: false},
+				{at: 20 * time.Second, details: available("True", ""), want: false},
+				{at: 25 * time.Second, details: available("False", ""), want: false},
+				{at: 30 * time.Second, details: available("True", ""), want: false},
+				{at: 59 * time.Second, details: available("True", ""), want: false},
+				{at: 60 * time.Second, details: available("True", ""), want: true},
+			},
+		},
+		{
+			name: "window started at lastTransitionTime",
+			expr: "Available for 30s",
+			observations: []observation{
+				{at: 0, details: available("True", "2024-05-01T09:59:00Z"), want: true},
+			},
+		},
+		{
+			name: "flip between polls detected from lastTransitionTime",
+			expr: "Available for 30s",
+			observations: []observation{
+				{at: 0, details: available("True", "2024-05-01T10:00:00Z"), want: false},
+				{at: 40 * time.Second, details: available("True", "2024-05-01T10:00:35Z"), want: false},
+				{at: 65 * time.Second, details: available("True", "2024-05-01T10:00:35Z"), want: true},
+			},
+		},
+		{
+			name: "window of a negated condition",
+			expr: "Available AND NOT Degraded for 10s",
+			observations: []observation{
+				{at: 0, details: available("False", ""), want: false},
+				{at: 10 * time.Second, details: available("True", ""), want: true},
+			},
+		},
+	}
+
+	log := logger.New(logger.Config{Level: "error", Format: "text"})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			condition, err := ParseCondition(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseCondition(%q) unexpected error: %v", tt.expr, err)
+			}
+			ctx := context.Background()
+			for _, obs := range tt.observations {
+				condition.observe(ctx, obs.details, log, t0.Add(obs.at))
+				if got := condition.root.eval(ctx, obs.details, log); got != obs.want {
+					t.Errorf("at %s: %q = %v, expected %v", obs.at, tt.expr, got, obs.want)
+				}
+			}
+		})
+	}
+}
+
+func TestStabilityWindowPendingUntil(t *testing.T) {
+	t0 := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	details := &ManifestWorkDetails{Conditions: []ConditionSummary{{Type: "Available", Status: "True"}}}
+	log := logger.New(logger.Config{Level: "error", Format: "text"})
+
+	condition, err := ParseCondition("Available")
+	if err != nil {
+		t.Fatalf("ParseCondition() unexpected error: %v", err)
+	}
+	condition.stableFor(time.Minute)
+	if condition.String() != "(Available) for 1m0s" {
+		t.Errorf("String() = %q", condition.String())
+	}
+	if !condition.pendingUntil().IsZero() {
+		t.Errorf("expected no running window before the first observation")
+	}
+
+	condition.observe(context.Background(), details, log, t0)
+	if got, want := condition.pendingUntil(), t0.Add(time.Minute); !got.Equal(want) {
+		t.Errorf("pendingUntil() = %s, expected %s", got, want)
+	}
+	condition.observe(context.Background(), details, log, t0.Add(time.Minute))
+	if !condition.pendingUntil().IsZero() {
+		t.Errorf("expected no running window once it completed")
+	}
+	trace := condition.Trace(context.Background(), details, log)
+	if !trace.Result || trace.Reason != "held for 1m0s of 1m0s" {
+		t.Errorf("unexpected trace %+v", trace)
+	}
+}
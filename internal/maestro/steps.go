@@ -0,0 +1,27 @@
+package maestro
+
+import "time"
+
+// Step statuses
+const (
+	StepStatusSucceeded = "Succeeded"
+	StepStatusFailed    = "Failed"
+)
+
+// Step records the outcome of one step of a multi-step operation such as a recreate or a rollback
+type Step struct {
+	Step      string    `json:"step"`
+	Status    string    `json:"status"` // Succeeded or Failed
+	Message   string    `json:"message,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// NewStep records a step, failed when err is not nil
+func NewStep(step, message string, err error) Step {
+	s := Step{Step: step, Status: StepStatusSucceeded, Message: message, Timestamp: time.Now()}
+	if err != nil {
+		s.Status = StepStatusFailed
+		s.Message = err.Error()
+	}
+	return s
+}
@@ -0,0 +1,100 @@
+package maestro
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ConditionTrace explains the evaluation of a condition expression against one ManifestWork status
+// It mirrors the expression: AND, OR and NOT have their operands, leaves have a check for the
+// ManifestWork condition or for each resource matched by their selector.
+type ConditionTrace struct {
+	Expr     string            `json:"expr"`
+	Result   bool              `json:"result"`
+	Operands []*ConditionTrace `json:"operands,omitempty"`
+	Checks   []ConditionCheck  `json:"checks,omitempty"`
+	// Reason explains a leaf without checks, e.g. that no resource matches its selector
+	Reason string `json:"reason,omitempty"`
+}
+
+// ConditionCheck is the evaluation of a leaf against the ManifestWork or one of its resources
+type ConditionCheck struct {
+	Resource string `json:"resource,omitempty"` // Kind/namespace/name, empty for ManifestWork conditions
+	Fresh    *bool  `json:"fresh,omitempty"`    // Whether the status is newer than the last apply, if known
+	Actual   string `json:"actual,omitempty"`   // Condition status or status feedback value found
+	Result   bool   `json:"result"`
+	Reason   string `json:"reason"`
+}
+
+// ConditionUnmetError is returned when a wait ends before its condition is met, e.g. on timeout
+// It wraps the cause, so errors.Is(err, context.DeadlineExceeded) still holds. Trace explains the
+// evaluation of the condition against the last status received.
+type ConditionUnmetError struct {
+	Name      string
+	Condition string
+	Trace     *ConditionTrace
+	Err       error
+}
+
+// Error implements the error interface
+func (e *ConditionUnmetError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the cause of the wait ending
+func (e *ConditionUnmetError) Unwrap() error {
+	return e.Err
+}
+
+// Tree renders the trace as an indented tree, one line per sub-expression and check
+//
+//	✗ Available AND Job:Complete
+//	  ✓ Available
+//	    ✓ ManifestWork: Available is True
+//	  ✗ Job:Complete
+//	    ✗ Job/default/pi: Complete is not reported
+func (t *ConditionTrace) Tree() string {
+	var sb strings.Builder
+	t.writeTree(&sb, "")
+	return sb.String()
+}
+
+func (t *ConditionTrace) writeTree(sb *strings.Builder, indent string) {
+	fmt.Fprintf(sb, "%s%s %s", indent, traceMark(t.Result), t.Expr)
+	if t.Reason != "" {
+		fmt.Fprintf(sb, ": %s", t.Reason)
+	}
+	sb.WriteString("\n")
+	for _, check := range t.Checks {
+		subject := check.Resource
+		if subject == "" {
+			subject = "ManifestWork"
+		}
+		fmt.Fprintf(sb, "%s  %s %s: %s\n", indent, traceMark(check.Result), subject, check.Reason)
+	}
+	for _, operand := range t.Operands {
+		operand.writeTree(sb, indent+"  ")
+	}
+}
+
+// traceMark returns the mark of a true or false result
+func traceMark(result bool) string {
+	if result {
+		return "✓"
+	}
+	return "✗"
+}
+
+// addCheck records a check of a leaf; it does nothing when the leaf is not traced
+func (t *ConditionTrace) addCheck(check ConditionCheck) {
+	if t != nil {
+		t.Checks = append(t.Checks, check)
+	}
+}
+
+// explain records why a leaf is false; it does nothing when the leaf is not traced
+func (t *ConditionTrace) explain(format string, args ...interface{}) {
+	if t != nil {
+		t.Reason = fmt.Sprintf(format, args...)
+	}
+}
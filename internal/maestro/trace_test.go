@@ -0,0 +1,172 @@
+package maestro
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
+)
+
+func TestConditionTrace(t *testing.T) {
+	details := &ManifestWorkDetails{
+		Conditions: []ConditionSummary{
+			{Type: "Applied", Status: "True", LastTransitionTime: "2024-05-01T10:00:00Z"},
+			{Type: "Available", Status: "True", LastTransitionTime: "2024-05-01T09:00:00Z"},
+			{Type: "Degraded", Status: "False", Reason: "AsExpected"},
+		},
+		ResourceStatus: []ResourceStatusInfo{
+			{
+				Kind:      "Job",
+				Name:      "old",
+				Namespace: "default",
+				Conditions: []ConditionSummary{
+					{Type: "Applied", Status: "True", LastTransitionTime: "2024-05-01T09:00:00Z"},
+				},
+			},
+			{
+				Kind:      "Job",
+				Name:      "pi",
+				Namespace: "default",
+				Conditions: []ConditionSummary{
+					{Type: "Applied", Status: "True", LastTransitionTime: "2024-05-01T10:00:01Z"},
+				},
+				StatusFeedback: map[string]interface{}{
+					"succeeded": int64(0),
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Complete", "status": "False"},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{
+			name: "stale ManifestWork condition",
+			expr: "Available AND NOT Degraded",
+			want: "✗ Available AND NOT Degraded\n" +
+				"  ✗ Available\n" +
+				"    ✗ ManifestWork: Available is True but stale: it transitioned at 2024-05-01T09:00:00Z, " +
+				"before Applied at 2024-05-01T10:00:00Z\n" +
+				"  ✓ NOT Degraded\n" +
+				"    ✗ Degraded\n" +
+				"      ✗ ManifestWork: Degraded is False (AsExpected)\n",
+		},
+		{
+			name: "resource conditions and comparisons",
+			expr: "Job:Complete OR Job/default/pi:succeeded>=1 OR Deployment:Available",
+			want: "✗ Job:Complete OR Job/default/pi:succeeded>=1 OR Deployment:Available\n" +
+				"  ✗ (Job:Complete OR Job/default/pi:succeeded>=1)\n" +
+				"    ✗ Job:Complete\n" +
+				"      ✗ Job/default/old: status is stale: resource applied at 2024-05-01T09:00:00Z, " +
+				"before the ManifestWork was applied at 2024-05-01T10:00:00Z\n" +
+				"      ✗ Job/default/pi: Complete is False\n" +
+				"    ✗ Job/default/pi:succeeded>=1\n" +
+				"      ✗ Job/default/pi: succeeded is 0\n" +
+				"  ✗ Deployment:Available: no resource matches Deployment\n",
+		},
+		{
+			name: "quantified resource condition",
+			expr: "all(Job/default/*):Complete",
+			want: "✗ all(Job/default/*):Complete: 0 of 2 matching resources satisfy the check\n" +
+				"  ✗ Job/default/old: status is stale: resource applied at 2024-05-01T09:00:00Z, " +
+				"before the ManifestWork was applied at 2024-05-01T10:00:00Z\n" +
+				"  ✗ Job/default/pi: Complete is False\n",
+		},
+		{
+			name: "missing feedback field",
+			expr: "Job/pi:active>0",
+			want: "✗ Job/pi:active>0\n" +
+				"  ✗ Job/default/pi: active is missing from the status feedback\n",
+		},
+	}
+
+	log := logger.New(logger.Config{Level: "error", Format: "text"})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			condition, err := ParseCondition(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseCondition(%q) unexpected error: %v", tt.expr, err)
+			}
+			trace := condition.Trace(context.Background(), details, log)
+			if got := trace.Tree(); got != tt.want {
+				t.Errorf("Tree() =\n%s\nexpected\n%s", got, tt.want)
+			}
+			if trace.Result != condition.Evaluate(context.Background(), details, log) {
+				t.Errorf("trace result %v disagrees with Evaluate", trace.Result)
+			}
+		})
+	}
+}
+
+func TestConditionTraceChecks(t *testing.T) {
+	details := &ManifestWorkDetails{
+		Conditions: []ConditionSummary{
+			{Type: "Applied", Status: "True", LastTransitionTime: "2024-05-01T10:00:00Z"},
+		},
+		ResourceStatus: []ResourceStatusInfo{
+			{
+				Kind: "Job",
+				Name: "pi",
+				Conditions: []ConditionSummary{
+					{Type: "Applied", Status: "True", LastTransitionTime: "2024-05-01T10:00:01Z"},
+				},
+				StatusFeedback: map[string]interface{}{"succeeded": int64(2)},
+			},
+		},
+	}
+
+	condition, err := ParseCondition("Job:succeeded>=1")
+	if err != nil {
+		t.Fatalf("ParseCondition() unexpected error: %v", err)
+	}
+	trace := condition.Trace(context.Background(), details, logger.New(logger.Config{Level: "error"}))
+	if !trace.Result || len(trace.Checks) != 1 {
+		t.Fatalf("expected one true check, got %+v", trace)
+	}
+	check := trace.Checks[0]
+	if check.Resource != "Job/pi" || check.Actual != "2" || !check.Result {
+		t.Errorf("unexpected check %+v", check)
+	}
+	if check.Fresh == nil || !*check.Fresh {
+		t.Errorf("expected fresh status, got %v", check.Fresh)
+	}
+}
+
+func TestConditionTraceStaleGeneration(t *testing.T) {
+	details := &ManifestWorkDetails{
+		Version: 3,
+		Conditions: []ConditionSummary{
+			{Type: "Applied", Status: "True", ObservedGeneration: 3},
+			{Type: "Available", Status: "True", ObservedGeneration: 2},
+		},
+		ResourceStatus: []ResourceStatusInfo{
+			{
+				Kind: "Job",
+				Name: "pi",
+				Conditions: []ConditionSummary{
+					{Type: "Applied", Status: "True", ObservedGeneration: 2},
+					{Type: "Complete", Status: "True"},
+				},
+			},
+		},
+	}
+
+	condition, err := ParseCondition("Available OR Job:Complete")
+	if err != nil {
+		t.Fatalf("ParseCondition() unexpected error: %v", err)
+	}
+	want := "✗ Available OR Job:Complete\n" +
+		"  ✗ Available\n" +
+		"    ✗ ManifestWork: Available is True but stale: it was observed at generation 2, the ManifestWork is at 3\n" +
+		"  ✗ Job:Complete\n" +
+		"    ✗ Job/pi: status is stale: resource observed generation 2, the ManifestWork is at 3\n"
+	trace := condition.Trace(context.Background(), details, logger.New(logger.Config{Level: "error"}))
+	if got := trace.Tree(); got != want {
+		t.Errorf("Tree() =\n%s\nexpected\n%s", got, want)
+	}
+}
@@ -0,0 +1,136 @@
+package manifestwork
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	workv1 "open-cluster-management.io/api/work/v1"
+)
+
+// wellKnownFeedback describes the resource and default feedback JSONPaths of a well-known kind
+type wellKnownFeedback struct {
+	resource string
+	paths    []workv1.JsonPath
+}
+
+// statusPath exposes the whole status, so conditions and paths like "status.phase" can be evaluated
+var statusPath = workv1.JsonPath{Name: "status", Path: ".status"}
+
+// wellKnownFeedbackRules holds the default feedback JSONPaths by "group/Kind"
+// Besides the whole status, the rules expose the fields used in wait conditions by their
+// short names (e.g. "Job:succeeded>=1", "Deployment:readyReplicas>=3").
+var wellKnownFeedbackRules = map[string]wellKnownFeedback{
+	"batch/Job": {resource: "jobs", paths: []workv1.JsonPath{
+		statusPath,
+		{Name: "conditions", Path: ".status.conditions"},
+		{Name: "succeeded", Path: ".status.succeeded"},
+		{Name: "failed", Path: ".status.failed"},
+		{Name: "active", Path: ".status.active"},
+	}},
+	"apps/Deployment": {resource: "deployments", paths: []workv1.JsonPath{
+		statusPath,
+		{Name: "conditions", Path: ".status.conditions"},
+		{Name: "replicas", Path: ".status.replicas"},
+		{Name: "readyReplicas", Path: ".status.readyReplicas"},
+		{Name: "availableReplicas", Path: ".status.availableReplicas"},
+		{Name: "updatedReplicas", Path: ".status.updatedReplicas"},
+		{Name: "observedGeneration", Path: ".status.observedGeneration"},
+	}},
+	"apps/StatefulSet": {resource: "statefulsets", paths: []workv1.JsonPath{
+		statusPath,
+		{Name: "replicas", Path: ".status.replicas"},
+		{Name: "readyReplicas", Path: ".status.readyReplicas"},
+		{Name: "availableReplicas", Path: ".status.availableReplicas"},
+		{Name: "updatedReplicas", Path: ".status.updatedReplicas"},
+		{Name: "observedGeneration", Path: ".status.observedGeneration"},
+	}},
+	"apps/DaemonSet": {resource: "daemonsets", paths: []workv1.JsonPath{
+		statusPath,
+		{Name: "desiredNumberScheduled", Path: ".status.desiredNumberScheduled"},
+		{Name: "numberReady", Path: ".status.numberReady"},
+		{Name: "numberAvailable", Path: ".status.numberAvailable"},
+		{Name: "updatedNumberScheduled", Path: ".status.updatedNumberScheduled"},
+		{Name: "observedGeneration", Path: ".status.observedGeneration"},
+	}},
+	"/Namespace": {resource: "namespaces", paths: []workv1.JsonPath{
+		statusPath,
+		{Name: "phase", Path: ".status.phase"},
+	}},
+	"/PersistentVolumeClaim": {resource: "persistentvolumeclaims", paths: []workv1.JsonPath{
+		statusPath,
+		{Name: "phase", Path: ".status.phase"},
+		{Name: "capacity", Path: ".status.capacity.storage"},
+	}},
+	"apiextensions.k8s.io/CustomResourceDefinition": {resource: "customresourcedefinitions", paths: []workv1.JsonPath{
+		statusPath,
+		{Name: "conditions", Path: ".status.conditions"},
+	}},
+}
+
+// AddFeedbackRules injects default feedback rules for the well-known manifests of a ManifestWork
+// Jobs, Deployments, StatefulSets, DaemonSets, Namespaces, PersistentVolumeClaims and
+// CustomResourceDefinitions get JSONPaths feedback rules unless a manifestConfig with feedback
+// rules already targets them; a matching manifestConfig without feedback rules is completed.
+// It returns the manifestConfigs that were added or completed.
+func AddFeedbackRules(mw *workv1.ManifestWork) ([]workv1.ManifestConfigOption, error) {
+	var injected []workv1.ManifestConfigOption
+	for i, manifest := range mw.Spec.Workload.Manifests {
+		var obj struct {
+			APIVersion string `json:"apiVersion"`
+			Kind       string `json:"kind"`
+			Metadata   struct {
+				Name      string `json:"name"`
+				Namespace string `json:"namespace"`
+			} `json:"metadata"`
+		}
+		if err := json.Unmarshal(manifest.Raw, &obj); err != nil {
+			return nil, fmt.Errorf("failed to decode manifest %d: %w", i, err)
+		}
+
+		group := ""
+		if slash := strings.LastIndex(obj.APIVersion, "/"); slash >= 0 {
+			group = obj.APIVersion[:slash]
+		}
+		known, ok := wellKnownFeedbackRules[group+"/"+obj.Kind]
+		if !ok || obj.Metadata.Name == "" {
+			continue
+		}
+
+		identifier := workv1.ResourceIdentifier{
+			Group:     group,
+			Resource:  known.resource,
+			Name:      obj.Metadata.Name,
+			Namespace: obj.Metadata.Namespace,
+		}
+		rules := []workv1.FeedbackRule{{
+			Type:      workv1.JSONPathsType,
+			JsonPaths: append([]workv1.JsonPath(nil), known.paths...),
+		}}
+
+		existing := findManifestConfig(mw.Spec.ManifestConfigs, identifier)
+		switch {
+		case existing == nil:
+			config := workv1.ManifestConfigOption{ResourceIdentifier: identifier, FeedbackRules: rules}
+			mw.Spec.ManifestConfigs = append(mw.Spec.ManifestConfigs, config)
+			injected = append(injected, config)
+		case len(existing.FeedbackRules) == 0:
+			existing.FeedbackRules = rules
+			injected = append(injected, *existing)
+		}
+	}
+	return injected, nil
+}
+
+// findManifestConfig returns the manifestConfig targeting the resource, or nil
+func findManifestConfig(
+	configs []workv1.ManifestConfigOption,
+	identifier workv1.ResourceIdentifier,
+) *workv1.ManifestConfigOption {
+	for i := range configs {
+		if configs[i].ResourceIdentifier == identifier {
+			return &configs[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package manifestwork
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	workv1 "open-cluster-management.io/api/work/v1"
+)
+
+func TestAddFeedbackRules(t *testing.T) {
+	manifest := func(raw string) workv1.Manifest {
+		return workv1.Manifest{RawExtension: runtime.RawExtension{Raw: []byte(raw)}}
+	}
+	mw := &workv1.ManifestWork{}
+	mw.Spec.Workload.Manifests = []workv1.Manifest{
+		manifest(`{"apiVersion":"batch/v1","kind":"Job","metadata":{"name":"job","namespace":"ns"}}`),
+		manifest(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"web","namespace":"ns"}}`),
+		manifest(`{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"ns"}}`),
+		manifest(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config","namespace":"ns"}}`),
+		manifest(`{"apiVersion":"apiextensions.k8s.io/v1","kind":"CustomResourceDefinition",` +
+			`"metadata":{"name":"widgets.example.com"}}`),
+	}
+	mw.Spec.ManifestConfigs = []workv1.ManifestConfigOption{
+		{
+			// Hand-written rules are kept
+			ResourceIdentifier: workv1.ResourceIdentifier{Group: "batch", Resource: "jobs", Name: "job", Namespace: "ns"},
+			FeedbackRules: []workv1.FeedbackRule{{
+				Type:      workv1.JSONPathsType,
+				JsonPaths: []workv1.JsonPath{{Name: "custom", Path: ".status.custom"}},
+			}},
+		},
+		{
+			// A config without feedback rules is completed
+			ResourceIdentifier: workv1.ResourceIdentifier{Resource: "namespaces", Name: "ns"},
+			UpdateStrategy:     &workv1.UpdateStrategy{Type: workv1.UpdateStrategyTypeServerSideApply},
+		},
+	}
+
+	injected, err := AddFeedbackRules(mw)
+	if err != nil {
+		t.Fatalf("AddFeedbackRules() unexpected error: %v", err)
+	}
+
+	var resources []string
+	for _, config := range injected {
+		resources = append(resources, config.ResourceIdentifier.Resource)
+	}
+	expected := []string{"deployments", "namespaces", "customresourcedefinitions"}
+	if len(resources) != len(expected) {
+		t.Fatalf("injected %v, expected %v", resources, expected)
+	}
+	for i := range expected {
+		if resources[i] != expected[i] {
+			t.Errorf("injected[%d] = %s, expected %s", i, resources[i], expected[i])
+		}
+	}
+
+	if len(mw.Spec.ManifestConfigs) != 4 {
+		t.Fatalf("expected 4 manifestConfigs, got %d", len(mw.Spec.ManifestConfigs))
+	}
+	if paths := mw.Spec.ManifestConfigs[0].FeedbackRules[0].JsonPaths; len(paths) != 1 || paths[0].Name != "custom" {
+		t.Errorf("hand-written Job feedback rules were changed: %+v", paths)
+	}
+	namespaceConfig := mw.Spec.ManifestConfigs[1]
+	if len(namespaceConfig.FeedbackRules) != 1 || namespaceConfig.UpdateStrategy == nil {
+		t.Errorf("Namespace config not completed in place: %+v", namespaceConfig)
+	}
+	deployment := mw.Spec.ManifestConfigs[2].ResourceIdentifier
+	if deployment.Group != "apps" || deployment.Name != "web" || deployment.Namespace != "ns" {
+		t.Errorf("unexpected Deployment identifier %+v", deployment)
+	}
+
+	// Injecting again is a no-op
+	if again, err := AddFeedbackRules(mw); err != nil || len(again) != 0 {
+		t.Errorf("second AddFeedbackRules() = %v, %v; expected nothing injected", again, err)
+	}
+}
@@ -0,0 +1,309 @@
+package manifestwork
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	workv1 "open-cluster-management.io/api/work/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// StdinPath is the manifest file path that reads from standard input
+const StdinPath = "-"
+
+// manifestFileExtensions are the extensions of the files loaded from a directory
+var manifestFileExtensions = map[string]bool{".yaml": true, ".yml": true, ".json": true}
+
+// LoadAll loads every ManifestWork from a file, a directory or standard input ("-")
+// Files may hold several YAML documents separated by "---". Directory entries are read
+// in lexical order; subdirectories are only read when recursive is set. ManifestWork
+// names must be unique across all documents.
+func LoadAll(path string, recursive bool) ([]*workv1.ManifestWork, error) {
+	return loadAll(path, recursive, os.Stdin)
+}
+
+// loadAll implements LoadAll reading "-" from stdin
+func loadAll(path string, recursive bool, stdin io.Reader) ([]*workv1.ManifestWork, error) {
+	inputs, err := readInputs(path, recursive, stdin)
+	if err != nil {
+		return nil, err
+	}
+
+	var works []*workv1.ManifestWork
+	sources := map[string]string{}
+	for _, in := range inputs {
+		loaded, err := DecodeManifestWorks(in.data, in.source)
+		if err != nil {
+			return nil, err
+		}
+		for _, mw := range loaded {
+			if previous, ok := sources[mw.Name]; ok {
+				if previous == in.source {
+					return nil, fmt.Errorf("ManifestWork %q is defined more than once in %s", mw.Name, in.source)
+				}
+				return nil, fmt.Errorf("ManifestWork %q is defined in both %s and %s", mw.Name, previous, in.source)
+			}
+			sources[mw.Name] = in.source
+			works = append(works, mw)
+		}
+	}
+
+	if len(works) == 0 {
+		return nil, fmt.Errorf("no ManifestWorks found in %s", path)
+	}
+	return works, nil
+}
+
+// input is the content of one file or of stdin
+type input struct {
+	source string // File path, or "stdin"
+	data   []byte
+}
+
+// readInputs reads a file, the manifest files of a directory or stdin ("-")
+func readInputs(path string, recursive bool, stdin io.Reader) ([]input, error) {
+	if path == StdinPath {
+		data, err := io.ReadAll(stdin)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read stdin: %w", err)
+		}
+		return []input{{source: "stdin", data: data}}, nil
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	files := []string{path}
+	if info.IsDir() {
+		if files, err = listManifestFiles(path, recursive); err != nil {
+			return nil, err
+		}
+	}
+
+	inputs := make([]input, 0, len(files))
+	for _, file := range files {
+		data, err := os.ReadFile(file) //nolint:gosec // This is intentional - CLI tool reads user-specified files
+		if err != nil {
+			return nil, fmt.Errorf("failed to read file %s: %w", file, err)
+		}
+		inputs = append(inputs, input{source: file, data: data})
+	}
+	return inputs, nil
+}
+
+// listManifestFiles returns the YAML and JSON files of a directory in lexical order
+func listManifestFiles(dir string, recursive bool) ([]string, error) {
+	var files []string
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if path != dir && !recursive {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if manifestFileExtensions[strings.ToLower(filepath.Ext(path))] {
+			files = append(files, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory %s: %w", dir, err)
+	}
+	return files, nil
+}
+
+// DecodeManifestWorks decodes the ManifestWorks of a YAML stream or JSON document
+// Empty documents are ignored; source names the input in error messages.
+func DecodeManifestWorks(data []byte, source string) ([]*workv1.ManifestWork, error) {
+	docs, err := splitDocuments(data, source)
+	if err != nil {
+		return nil, err
+	}
+
+	works := make([]*workv1.ManifestWork, 0, len(docs))
+	for i, doc := range docs {
+		mw, err := decodeManifestWork(doc, documentSource(source, i, len(docs)))
+		if err != nil {
+			return nil, err
+		}
+		works = append(works, mw)
+	}
+	return works, nil
+}
+
+// LoadManifests loads Kubernetes manifests to wrap into a ManifestWork from files, directories or stdin ("-")
+// Each document may be a plain Kubernetes object, a v1 List of objects, or one of the shapes
+// LoadSourceFile understands: a full ManifestWork, a spec, a manifests array or a workload object.
+// The manifestConfigs of ManifestWork and spec documents are kept. A manifest may only be defined once.
+func LoadManifests(paths []string, recursive bool) (*SourceFile, error) {
+	return loadManifests(paths, recursive, os.Stdin)
+}
+
+// loadManifests implements LoadManifests reading "-" from stdin
+func loadManifests(paths []string, recursive bool, stdin io.Reader) (*SourceFile, error) {
+	result := &SourceFile{}
+	sources := map[string]string{}
+	for _, path := range paths {
+		inputs, err := readInputs(path, recursive, stdin)
+		if err != nil {
+			return nil, err
+		}
+		for _, in := range inputs {
+			docs, err := splitDocuments(in.data, in.source)
+			if err != nil {
+				return nil, err
+			}
+			for i, doc := range docs {
+				source := documentSource(in.source, i, len(docs))
+				manifests, configs, err := decodeManifests(doc, source)
+				if err != nil {
+					return nil, err
+				}
+				for _, m := range manifests {
+					key := getManifestKey(m)
+					if key == "" {
+						continue
+					}
+					if previous, ok := sources[key]; ok {
+						return nil, fmt.Errorf("manifest %s is defined in both %s and %s", key, previous, source)
+					}
+					sources[key] = source
+				}
+				result.Manifests = append(result.Manifests, manifests...)
+				if len(configs) > 0 {
+					if result.Spec == nil {
+						result.Spec = &workv1.ManifestWorkSpec{}
+					}
+					result.Spec.ManifestConfigs = append(result.Spec.ManifestConfigs, configs...)
+				}
+			}
+		}
+	}
+
+	if len(result.Manifests) == 0 {
+		return nil, fmt.Errorf("no Kubernetes manifests found in %s", strings.Join(paths, ", "))
+	}
+	if result.Spec != nil {
+		result.Spec.Workload.Manifests = result.Manifests
+	}
+	return result, nil
+}
+
+// decodeManifests returns the manifests and manifest configs of one document
+func decodeManifests(doc []byte, source string) ([]workv1.Manifest, []workv1.ManifestConfigOption, error) {
+	var object map[string]interface{}
+	if err := yaml.Unmarshal(doc, &object); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal %s: %w", source, err)
+	}
+	kind, _ := object["kind"].(string)
+	apiVersion, _ := object["apiVersion"].(string)
+
+	switch {
+	case kind == kindManifestWork:
+		mw, err := decodeManifestWork(doc, source)
+		if err != nil {
+			return nil, nil, err
+		}
+		return mw.Spec.Workload.Manifests, mw.Spec.ManifestConfigs, nil
+	case kind == "List" && apiVersion == "v1":
+		items, _ := object["items"].([]interface{})
+		manifests := make([]workv1.Manifest, 0, len(items))
+		for i, item := range items {
+			itemObject, _ := item.(map[string]interface{})
+			m, err := toManifest(itemObject, fmt.Sprintf("%s item %d", source, i+1))
+			if err != nil {
+				return nil, nil, err
+			}
+			manifests = append(manifests, m)
+		}
+		return manifests, nil, nil
+	case kind != "":
+		m, err := toManifest(object, source)
+		if err != nil {
+			return nil, nil, err
+		}
+		return []workv1.Manifest{m}, nil, nil
+	}
+
+	// No kind: one of the source file shapes
+	var sourceFile SourceFile
+	if err := yaml.Unmarshal(doc, &sourceFile); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal source file %s: %w", source, err)
+	}
+	manifests := sourceFile.GetManifests()
+	if len(manifests) == 0 {
+		return nil, nil, fmt.Errorf("%s is neither a Kubernetes object nor a spec, manifests or workload", source)
+	}
+	for i, m := range manifests {
+		if getManifestKey(m) == "" {
+			return nil, nil, fmt.Errorf("manifest %d in %s must have a kind and metadata.name", i+1, source)
+		}
+	}
+	var configs []workv1.ManifestConfigOption
+	if sourceFile.Spec != nil {
+		configs = sourceFile.Spec.ManifestConfigs
+	}
+	return manifests, configs, nil
+}
+
+// toManifest converts a Kubernetes object into a manifest, checking it is addressable
+func toManifest(object map[string]interface{}, source string) (workv1.Manifest, error) {
+	if apiVersion, _ := object["apiVersion"].(string); apiVersion == "" {
+		return workv1.Manifest{}, fmt.Errorf("%s must have apiVersion, kind and metadata.name", source)
+	}
+
+	raw, err := json.Marshal(object)
+	if err != nil {
+		return workv1.Manifest{}, fmt.Errorf("failed to encode %s: %w", source, err)
+	}
+	m := workv1.Manifest{RawExtension: runtime.RawExtension{Raw: raw}}
+	if getManifestKey(m) == "" {
+		return workv1.Manifest{}, fmt.Errorf("%s must have apiVersion, kind and metadata.name", source)
+	}
+	return m, nil
+}
+
+// splitDocuments splits a YAML stream or JSON document into its non-empty documents
+func splitDocuments(data []byte, source string) ([][]byte, error) {
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+
+	var docs [][]byte
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return docs, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", source, err)
+		}
+		var content map[string]interface{}
+		if err := yaml.Unmarshal(doc, &content); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s: %w", source, err)
+		}
+		if len(content) > 0 {
+			docs = append(docs, doc)
+		}
+	}
+}
+
+// documentSource names document i of count documents of source in error messages
+func documentSource(source string, i, count int) string {
+	if count > 1 {
+		return fmt.Sprintf("%s (document %d)", source, i+1)
+	}
+	return source
+}
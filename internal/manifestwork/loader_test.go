@@ -0,0 +1,217 @@
+package manifestwork
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	workv1 "open-cluster-management.io/api/work/v1"
+)
+
+const testWork = `apiVersion: work.open-cluster-management.io/v1
+kind: ManifestWork
+metadata:
+  name: %s
+spec:
+  workload:
+    manifests:
+    - apiVersion: v1
+      kind: ConfigMap
+      metadata:
+        name: config
+        namespace: default
+`
+
+func testWorkYAML(name string) string {
+	return fmt.Sprintf(testWork, name)
+}
+
+func workNames(works []*workv1.ManifestWork) []string {
+	names := make([]string, 0, len(works))
+	for _, mw := range works {
+		names = append(names, mw.Name)
+	}
+	return names
+}
+
+func TestLoadAll(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(path, content string) {
+		t.Helper()
+		path = filepath.Join(dir, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	writeFile("b.yaml", testWorkYAML("work-b1")+"---\n# comment only\n---\n"+testWorkYAML("work-b2"))
+	writeFile("a.json", `{"apiVersion":"work.open-cluster-management.io/v1","kind":"ManifestWork",`+
+		`"metadata":{"name":"work-a"}}`)
+	writeFile("notes.txt", "not a manifest")
+	writeFile("nested/c.yml", testWorkYAML("work-c"))
+
+	tests := []struct {
+		name      string
+		path      string
+		recursive bool
+		stdin     string
+		expected  []string
+	}{
+		{name: "multi-document file", path: filepath.Join(dir, "b.yaml"), expected: []string{"work-b1", "work-b2"}},
+		{name: "directory", path: dir, expected: []string{"work-a", "work-b1", "work-b2"}},
+		{
+			name:      "recursive directory",
+			path:      dir,
+			recursive: true,
+			expected:  []string{"work-a", "work-b1", "work-b2", "work-c"},
+		},
+		{
+			name:     "stdin",
+			path:     StdinPath,
+			stdin:    testWorkYAML("work-x") + "---\n" + testWorkYAML("work-y"),
+			expected: []string{"work-x", "work-y"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			works, err := loadAll(tt.path, tt.recursive, strings.NewReader(tt.stdin))
+			if err != nil {
+				t.Fatalf("loadAll() unexpected error: %v", err)
+			}
+			if names := workNames(works); !reflect.DeepEqual(names, tt.expected) {
+				t.Errorf("loadAll() = %v, expected %v", names, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLoadAllErrors(t *testing.T) {
+	dir := t.TempDir()
+	duplicate := filepath.Join(dir, "duplicate.yaml")
+	if err := os.WriteFile(duplicate, []byte(testWorkYAML("work")+"---\n"+testWorkYAML("work")), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	unnamed := filepath.Join(dir, "unnamed.yaml")
+	if err := os.WriteFile(unnamed, []byte(testWorkYAML("work")+"---\nkind: ManifestWork\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		stdin    string
+		contains string
+	}{
+		{name: "duplicate names", path: duplicate, contains: `ManifestWork "work" is defined more than once in`},
+		{name: "unnamed document", path: unnamed, contains: "(document 2) must have a name"},
+		{name: "empty stdin", path: StdinPath, contains: "no ManifestWorks found in -"},
+		{name: "missing path", path: filepath.Join(dir, "missing"), contains: "failed to read"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := loadAll(tt.path, false, strings.NewReader(tt.stdin))
+			if err == nil || !strings.Contains(err.Error(), tt.contains) {
+				t.Errorf("loadAll() error = %v, expected it to contain %q", err, tt.contains)
+			}
+		})
+	}
+}
+
+func TestLoadManifests(t *testing.T) {
+	dir := t.TempDir()
+	deployment := filepath.Join(dir, "deployment.yaml")
+	if err := os.WriteFile(deployment, []byte(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: nginx
+  namespace: default
+spec:
+  replicas: 1
+---
+apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: Service
+  metadata:
+    name: nginx
+    namespace: default
+`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	spec := filepath.Join(dir, "spec.yaml")
+	if err := os.WriteFile(spec, []byte(`spec:
+  workload:
+    manifests:
+    - apiVersion: v1
+      kind: ConfigMap
+      metadata:
+        name: config
+        namespace: default
+  manifestConfigs:
+  - resourceIdentifier:
+      resource: configmaps
+      name: config
+      namespace: default
+    feedbackRules:
+    - type: JSONPaths
+      jsonPaths:
+      - name: data
+        path: .data
+`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	source, err := loadManifests([]string{deployment, spec}, false, strings.NewReader(""))
+	if err != nil {
+		t.Fatalf("loadManifests() unexpected error: %v", err)
+	}
+	mw := source.ToManifestWork("nginx")
+
+	var keys []string
+	for _, m := range mw.Spec.Workload.Manifests {
+		keys = append(keys, getManifestKey(m))
+	}
+	expected := []string{"Deployment/default/nginx", "Service/default/nginx", "ConfigMap/default/config"}
+	if !reflect.DeepEqual(keys, expected) {
+		t.Errorf("manifests = %v, expected %v", keys, expected)
+	}
+	if mw.Name != "nginx" || len(mw.Spec.ManifestConfigs) != 1 {
+		t.Errorf("unexpected ManifestWork %s with %d manifestConfigs", mw.Name, len(mw.Spec.ManifestConfigs))
+	}
+
+	tests := []struct {
+		name     string
+		stdin    string
+		contains string
+	}{
+		{
+			name:     "duplicate manifest",
+			stdin:    strings.Repeat("apiVersion: v1\nkind: Namespace\nmetadata:\n  name: ns\n---\n", 2),
+			contains: "manifest Namespace//ns is defined in both",
+		},
+		{
+			name:     "object without name",
+			stdin:    "apiVersion: v1\nkind: Namespace\n",
+			contains: "must have apiVersion, kind and metadata.name",
+		},
+		{name: "unknown shape", stdin: "foo: bar\n", contains: "is neither a Kubernetes object"},
+		{name: "empty", stdin: "", contains: "no Kubernetes manifests found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := loadManifests([]string{StdinPath}, false, strings.NewReader(tt.stdin))
+			if err == nil || !strings.Contains(err.Error(), tt.contains) {
+				t.Errorf("loadManifests() error = %v, expected it to contain %q", err, tt.contains)
+			}
+		})
+	}
+}
@@ -32,13 +32,217 @@ type StatusResult struct {
 	UpdatedAt string `json:"updatedAt,omitempty"` // Last update timestamp
 
 	// Operation result
-	Status    string    `json:"status"`    // Applied, Failed, InProgress, Available, Progressing, Degraded
-	Message   string    `json:"message"`   // Human-readable message
-	Timestamp time.Time `json:"timestamp"` // When this result was recorded
+	Operation string    `json:"operation,omitempty"` // Created, Updated, Recreated or Unchanged (apply only)
+	Changed   *bool     `json:"changed,omitempty"`   // Whether the apply changed the ManifestWork (apply only)
+	Status    string    `json:"status"`              // Applied, Failed, InProgress, Available, Progressing, Degraded
+	Message   string    `json:"message"`             // Human-readable message
+	Timestamp time.Time `json:"timestamp"`           // When this result was recorded
+
+	// Sub-expression of the --fail-on condition that failed the wait
+	FailedCondition string `json:"failedCondition,omitempty"`
+	// Evaluation of the wait condition against the last status when the wait timed out
+	Trace *maestro.ConditionTrace `json:"trace,omitempty"`
 
 	// Detailed status
 	Conditions []ConditionInfo  `json:"conditions,omitempty"` // ManifestWork-level conditions
 	Resources  []ResourceStatus `json:"resources,omitempty"`  // Per-manifest status with K8s conditions
+
+	// Steps of a delete-and-recreate apply
+	Steps []maestro.Step `json:"steps,omitempty"`
+	// Steps of the rollback of a failed apply (--atomic)
+	Rollback []maestro.Step `json:"rollback,omitempty"`
+}
+
+// OperationChanged reports whether an apply operation changed the ManifestWork, for StatusResult.Changed
+func OperationChanged(operation string) *bool {
+	changed := operation != maestro.ApplyOperationUnchanged
+	return &changed
+}
+
+// Aggregate statuses of an operation run against multiple consumers
+const (
+	AggregateStatusSucceeded       = "Succeeded"
+	AggregateStatusPartiallyFailed = "PartiallyFailed"
+	AggregateStatusFailed          = "Failed"
+
+	// StatusSkipped is the per-consumer status of consumers not processed after a fail-fast failure
+	StatusSkipped = "Skipped"
+	// StatusFailed is the per-consumer status of consumers where the operation failed
+	StatusFailed = "Failed"
+	// StatusRolledBack is the status of a failed apply that was rolled back (--atomic)
+	StatusRolledBack = "RolledBack"
+	// StatusRollbackFailed is the status of a failed apply whose rollback failed too (--atomic)
+	StatusRollbackFailed = "RollbackFailed"
+	// StatusWaiting is the status of a ManifestWork whose awaited condition is not met yet
+	StatusWaiting = "Waiting"
+)
+
+// IsFailedStatus reports whether a per-consumer or per-ManifestWork status is a failure
+func IsFailedStatus(status string) bool {
+	return status == StatusFailed || status == StatusRolledBack || status == StatusRollbackFailed
+}
+
+// AggregateResult represents the result of an operation run against multiple consumers
+type AggregateResult struct {
+	Name      string    `json:"name"`      // ManifestWork name
+	Operation string    `json:"operation"` // apply, delete, wait
+	Status    string    `json:"status"`    // Succeeded, PartiallyFailed, Failed
+	Message   string    `json:"message"`   // Human-readable summary
+	Timestamp time.Time `json:"timestamp"` // When this result was recorded
+
+	Total     int `json:"total"`
+	Succeeded int `json:"succeeded"`
+	Failed    int `json:"failed"`
+	Skipped   int `json:"skipped"`
+
+	Results []StatusResult `json:"results"` // Per-consumer results, ordered by consumer
+}
+
+// NewAggregateResult summarizes per-consumer results
+// A consumer counts as failed when its status is a failure (see IsFailedStatus) and as skipped
+// when it is Skipped.
+func NewAggregateResult(name, operation string, results []StatusResult) AggregateResult {
+	aggregate := AggregateResult{
+		Name:      name,
+		Operation: operation,
+		Timestamp: time.Now(),
+		Total:     len(results),
+		Results:   results,
+	}
+	for _, r := range results {
+		switch {
+		case IsFailedStatus(r.Status):
+			aggregate.Failed++
+		case r.Status == StatusSkipped:
+			aggregate.Skipped++
+		default:
+			aggregate.Succeeded++
+		}
+	}
+
+	switch {
+	case aggregate.Failed == 0 && aggregate.Skipped == 0:
+		aggregate.Status = AggregateStatusSucceeded
+	case aggregate.Succeeded == 0:
+		aggregate.Status = AggregateStatusFailed
+	default:
+		aggregate.Status = AggregateStatusPartiallyFailed
+	}
+	aggregate.Message = fmt.Sprintf("%s %s: %d succeeded, %d failed, %d skipped of %d consumer(s)",
+		operation, name, aggregate.Succeeded, aggregate.Failed, aggregate.Skipped, aggregate.Total)
+
+	return aggregate
+}
+
+// ApplySummary represents the result of applying several ManifestWorks to one consumer
+type ApplySummary struct {
+	Consumer  string    `json:"consumer"`  // Consumer/cluster name
+	Status    string    `json:"status"`    // Succeeded, PartiallyFailed, Failed
+	Message   string    `json:"message"`   // Human-readable summary
+	Timestamp time.Time `json:"timestamp"` // When this result was recorded
+
+	Total     int `json:"total"`
+	Created   int `json:"created"`
+	Updated   int `json:"updated"`
+	Recreated int `json:"recreated"`
+	Unchanged int `json:"unchanged"`
+	Failed    int `json:"failed"`
+
+	Results []StatusResult `json:"results"` // Per-ManifestWork results, in the order they were applied
+}
+
+// NewApplySummary summarizes per-ManifestWork apply results
+// A work counts as failed when its status is a failure (see IsFailedStatus), even if it was applied
+// before its wait failed; otherwise it is counted by the operation performed.
+func NewApplySummary(consumer string, results []StatusResult) ApplySummary {
+	summary := ApplySummary{
+		Consumer:  consumer,
+		Timestamp: time.Now(),
+		Total:     len(results),
+		Results:   results,
+	}
+	for _, r := range results {
+		switch {
+		case IsFailedStatus(r.Status):
+			summary.Failed++
+		case r.Operation == maestro.ApplyOperationCreated:
+			summary.Created++
+		case r.Operation == maestro.ApplyOperationUpdated:
+			summary.Updated++
+		case r.Operation == maestro.ApplyOperationRecreated:
+			summary.Recreated++
+		default:
+			summary.Unchanged++
+		}
+	}
+
+	switch {
+	case summary.Failed == 0:
+		summary.Status = AggregateStatusSucceeded
+	case summary.Failed == summary.Total:
+		summary.Status = AggregateStatusFailed
+	default:
+		summary.Status = AggregateStatusPartiallyFailed
+	}
+	summary.Message = fmt.Sprintf("%d created, %d updated, %d recreated, %d unchanged, %d failed of %d ManifestWork(s)",
+		summary.Created, summary.Updated, summary.Recreated, summary.Unchanged, summary.Failed, summary.Total)
+
+	return summary
+}
+
+// WaitSummary represents the result of waiting for a condition on several ManifestWorks of one consumer
+type WaitSummary struct {
+	Consumer  string    `json:"consumer"`  // Consumer/cluster name
+	Condition string    `json:"condition"` // Condition awaited on every ManifestWork
+	Mode      string    `json:"mode"`      // all or any
+	Status    string    `json:"status"`    // Succeeded, PartiallyFailed, Failed
+	Message   string    `json:"message"`   // Human-readable summary
+	Timestamp time.Time `json:"timestamp"` // When this result was recorded
+
+	Total   int `json:"total"`
+	Met     int `json:"met"`
+	Failed  int `json:"failed"`
+	Waiting int `json:"waiting"`
+
+	Results []StatusResult `json:"results"` // Per-ManifestWork results, ordered by name
+}
+
+// NewWaitSummary summarizes per-ManifestWork wait results
+// A work counts as failed when its status is a failure (see IsFailedStatus), as waiting when it is
+// Waiting, and as met otherwise. The wait succeeded when every work met the condition, or with mode
+// any when one did.
+func NewWaitSummary(consumer, condition, mode string, results []StatusResult) WaitSummary {
+	summary := WaitSummary{
+		Consumer:  consumer,
+		Condition: condition,
+		Mode:      mode,
+		Timestamp: time.Now(),
+		Total:     len(results),
+		Results:   results,
+	}
+	for _, r := range results {
+		switch {
+		case IsFailedStatus(r.Status):
+			summary.Failed++
+		case r.Status == StatusWaiting:
+			summary.Waiting++
+		default:
+			summary.Met++
+		}
+	}
+
+	switch {
+	case summary.Met == summary.Total || (mode == maestro.WaitModeAny && summary.Met > 0):
+		summary.Status = AggregateStatusSucceeded
+	case summary.Met == 0:
+		summary.Status = AggregateStatusFailed
+	default:
+		summary.Status = AggregateStatusPartiallyFailed
+	}
+	summary.Message = fmt.Sprintf("%d met, %d failed, %d waiting of %d ManifestWork(s) (mode %s)",
+		summary.Met, summary.Failed, summary.Waiting, summary.Total, mode)
+
+	return summary
 }
 
 // ConditionInfo represents a ManifestWork condition
@@ -48,6 +252,7 @@ type ConditionInfo struct {
 	Reason             string `json:"reason,omitempty"`
 	Message            string `json:"message,omitempty"`
 	LastTransitionTime string `json:"lastTransitionTime,omitempty"`
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
 }
 
 // ResourceStatus represents the status of individual resources within a ManifestWork
@@ -86,11 +291,16 @@ func LoadFromFile(filePath string) (*workv1.ManifestWork, error) {
 		return nil, fmt.Errorf("failed to read file %s: %w", filePath, err)
 	}
 
+	return decodeManifestWork(data, filePath)
+}
+
+// decodeManifestWork decodes and checks a single ManifestWork document; source names it in errors
+func decodeManifestWork(data []byte, source string) (*workv1.ManifestWork, error) {
 	var manifestWork workv1.ManifestWork
 
 	// Try to unmarshal as YAML first (YAML is a superset of JSON)
 	if err := yaml.Unmarshal(data, &manifestWork); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal ManifestWork from %s: %w", filePath, err)
+		return nil, fmt.Errorf("failed to unmarshal ManifestWork from %s: %w", source, err)
 	}
 
 	// Validate that it's actually a ManifestWork
@@ -102,11 +312,11 @@ func LoadFromFile(filePath string) (*workv1.ManifestWork, error) {
 	}
 
 	if manifestWork.APIVersion != apiVersionManifestWork || manifestWork.Kind != kindManifestWork {
-		return nil, fmt.Errorf("file %s does not contain a valid ManifestWork resource", filePath)
+		return nil, fmt.Errorf("%s does not contain a valid ManifestWork resource", source)
 	}
 
 	if manifestWork.Name == "" {
-		return nil, fmt.Errorf("ManifestWork in %s must have a name", filePath)
+		return nil, fmt.Errorf("ManifestWork in %s must have a name", source)
 	}
 
 	return &manifestWork, nil
@@ -395,6 +605,26 @@ func WriteToFile(mw *workv1.ManifestWork, filePath string) error {
 
 // WriteResult writes the status result to the specified path for status-reporter integration
 func WriteResult(resultsPath string, result StatusResult) error {
+	return writeResultFile(resultsPath, result)
+}
+
+// WriteAggregateResult writes the result of a multi-consumer operation to the specified path
+func WriteAggregateResult(resultsPath string, result AggregateResult) error {
+	return writeResultFile(resultsPath, result)
+}
+
+// WriteApplySummary writes the summary of a multi-ManifestWork apply to the specified path
+func WriteApplySummary(resultsPath string, summary ApplySummary) error {
+	return writeResultFile(resultsPath, summary)
+}
+
+// WriteWaitSummary writes the summary of a multi-ManifestWork wait to the specified path
+func WriteWaitSummary(resultsPath string, summary WaitSummary) error {
+	return writeResultFile(resultsPath, summary)
+}
+
+// writeResultFile writes a result as JSON to resultsPath, or to $RESULTS_PATH when resultsPath is empty
+func writeResultFile(resultsPath string, result interface{}) error {
 	if resultsPath == "" {
 		// Check environment variable
 		resultsPath = os.Getenv("RESULTS_PATH")
@@ -441,6 +671,7 @@ func BuildStatusResult(name, consumer, status, message string, details *maestro.
 				Reason:             c.Reason,
 				Message:            c.Message,
 				LastTransitionTime: c.LastTransitionTime,
+				ObservedGeneration: c.ObservedGeneration,
 			})
 		}
 
@@ -456,10 +687,11 @@ func BuildStatusResult(name, consumer, status, message string, details *maestro.
 			}
 			for _, c := range rs.Conditions {
 				resStatus.Conditions = append(resStatus.Conditions, ConditionInfo{
-					Type:    c.Type,
-					Status:  c.Status,
-					Reason:  c.Reason,
-					Message: c.Message,
+					Type:               c.Type,
+					Status:             c.Status,
+					Reason:             c.Reason,
+					Message:            c.Message,
+					ObservedGeneration: c.ObservedGeneration,
 				})
 			}
 			result.Resources = append(result.Resources, resStatus)
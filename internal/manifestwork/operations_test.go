@@ -8,6 +8,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	workv1 "open-cluster-management.io/api/work/v1"
+
+	"github.com/openshift-hyperfleet/maestro-cli/internal/maestro"
 )
 
 func TestLoadSourceFile(t *testing.T) {
@@ -402,3 +404,123 @@ func TestBuildStatusResult(t *testing.T) {
 		t.Error("expected non-zero timestamp")
 	}
 }
+
+func TestNewAggregateResult(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []string
+		expected string
+		failed   int
+		skipped  int
+	}{
+		{name: "all succeeded", statuses: []string{"Applied", "Applied"}, expected: AggregateStatusSucceeded},
+		{
+			name:     "partial failure",
+			statuses: []string{"Applied", StatusFailed, StatusSkipped},
+			expected: AggregateStatusPartiallyFailed,
+			failed:   1,
+			skipped:  1,
+		},
+		{
+			name:     "all failed",
+			statuses: []string{StatusFailed, StatusSkipped},
+			expected: AggregateStatusFailed,
+			failed:   1,
+			skipped:  1,
+		},
+		{
+			name:     "rolled back counts as failed",
+			statuses: []string{"Applied", StatusRolledBack, StatusRollbackFailed},
+			expected: AggregateStatusPartiallyFailed,
+			failed:   2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var results []StatusResult
+			for i, status := range tt.statuses {
+				results = append(results, StatusResult{Name: "work", Consumer: string(rune('a' + i)), Status: status})
+			}
+
+			aggregate := NewAggregateResult("work", "apply", results)
+			if aggregate.Status != tt.expected {
+				t.Errorf("Status = %q, expected %q", aggregate.Status, tt.expected)
+			}
+			if aggregate.Total != len(tt.statuses) || aggregate.Failed != tt.failed || aggregate.Skipped != tt.skipped {
+				t.Errorf("unexpected counts: total=%d failed=%d skipped=%d", aggregate.Total, aggregate.Failed, aggregate.Skipped)
+			}
+			if aggregate.Succeeded != aggregate.Total-tt.failed-tt.skipped {
+				t.Errorf("Succeeded = %d, expected %d", aggregate.Succeeded, aggregate.Total-tt.failed-tt.skipped)
+			}
+		})
+	}
+}
+
+func TestNewApplySummary(t *testing.T) {
+	results := []StatusResult{
+		{Name: "a", Operation: maestro.ApplyOperationCreated, Status: "Applied"},
+		{Name: "b", Operation: maestro.ApplyOperationUpdated, Status: "Available"},
+		{Name: "c", Operation: maestro.ApplyOperationUnchanged, Status: "Applied"},
+		{Name: "r", Operation: maestro.ApplyOperationRecreated, Status: "Applied"},
+		{Name: "d", Operation: maestro.ApplyOperationCreated, Status: StatusFailed},
+		{Name: "e", Status: StatusFailed},
+		{Name: "f", Operation: maestro.ApplyOperationUpdated, Status: StatusRolledBack},
+	}
+
+	summary := NewApplySummary("cluster1", results)
+	if summary.Status != AggregateStatusPartiallyFailed {
+		t.Errorf("Status = %q, expected %q", summary.Status, AggregateStatusPartiallyFailed)
+	}
+	if summary.Total != 7 || summary.Created != 1 || summary.Updated != 1 || summary.Recreated != 1 ||
+		summary.Unchanged != 1 || summary.Failed != 3 {
+		t.Errorf("unexpected counts: %+v", summary)
+	}
+	expected := "1 created, 1 updated, 1 recreated, 1 unchanged, 3 failed of 7 ManifestWork(s)"
+	if summary.Message != expected {
+		t.Errorf("Message = %q, expected %q", summary.Message, expected)
+	}
+
+	if s := NewApplySummary("cluster1", results[:4]); s.Status != AggregateStatusSucceeded {
+		t.Errorf("Status = %q, expected %q", s.Status, AggregateStatusSucceeded)
+	}
+	if s := NewApplySummary("cluster1", results[4:]); s.Status != AggregateStatusFailed {
+		t.Errorf("Status = %q, expected %q", s.Status, AggregateStatusFailed)
+	}
+}
+
+func TestNewWaitSummary(t *testing.T) {
+	results := []StatusResult{
+		{Name: "a", Status: "Available"},
+		{Name: "b", Status: StatusWaiting},
+		{Name: "c", Status: StatusFailed},
+	}
+
+	summary := NewWaitSummary("cluster1", "Available", maestro.WaitModeAll, results)
+	if summary.Status != AggregateStatusPartiallyFailed {
+		t.Errorf("Status = %q, expected %q", summary.Status, AggregateStatusPartiallyFailed)
+	}
+	if summary.Total != 3 || summary.Met != 1 || summary.Waiting != 1 || summary.Failed != 1 {
+		t.Errorf("unexpected counts: %+v", summary)
+	}
+	expected := "1 met, 1 failed, 1 waiting of 3 ManifestWork(s) (mode all)"
+	if summary.Message != expected {
+		t.Errorf("Message = %q, expected %q", summary.Message, expected)
+	}
+
+	tests := []struct {
+		mode     string
+		results  []StatusResult
+		expected string
+	}{
+		{mode: maestro.WaitModeAny, results: results, expected: AggregateStatusSucceeded},
+		{mode: maestro.WaitModeAll, results: results[:1], expected: AggregateStatusSucceeded},
+		{mode: maestro.WaitModeAny, results: results[1:], expected: AggregateStatusFailed},
+		{mode: maestro.WaitModeAll, results: results[1:], expected: AggregateStatusFailed},
+	}
+	for _, tt := range tests {
+		if s := NewWaitSummary("cluster1", "Available", tt.mode, tt.results); s.Status != tt.expected {
+			t.Errorf("mode %s with %d result(s): Status = %q, expected %q", tt.mode, len(tt.results), s.Status, tt.expected)
+		}
+	}
+}
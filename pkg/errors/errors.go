@@ -0,0 +1,273 @@
+// Package errors provides the typed errors reported by maestro-cli and the process exit code for each type.
+//
+// Exit codes:
+//
+//	0  success
+//	1  general error (unclassified failure)
+//	2  ValidationFailed - invalid flags, manifests or condition expressions
+//	3  NotFound         - consumer or ManifestWork does not exist
+//	4  Unauthorized     - authentication or authorization failed (HTTP 401/403)
+//	5  Conflict         - the resource was modified concurrently or already exists (HTTP 409)
+//	6  Timeout          - the operation or a wait did not complete in time
+//	7  ConditionFailed  - a waited-for ManifestWork met its --fail-on condition
+//	8  ServerError      - Maestro returned a server error (HTTP 429/5xx) after retries
+//	9  RolledBack       - an atomic apply failed and was rolled back
+//	10 RollbackFailed   - an atomic apply failed and rolling it back failed too
+package errors
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Type identifies the category of a maestro-cli error
+type Type string
+
+// Error types
+const (
+	TypeGeneral          Type = "Error"
+	TypeValidationFailed Type = "ValidationFailed"
+	TypeNotFound         Type = "NotFound"
+	TypeUnauthorized     Type = "Unauthorized"
+	TypeConflict         Type = "Conflict"
+	TypeTimeout          Type = "Timeout"
+	TypeConditionFailed  Type = "ConditionFailed"
+	TypeServerError      Type = "ServerError"
+	TypeRolledBack       Type = "RolledBack"
+	TypeRollbackFailed   Type = "RollbackFailed"
+)
+
+// Process exit codes for each error type
+const (
+	ExitSuccess          = 0
+	ExitGeneral          = 1
+	ExitValidationFailed = 2
+	ExitNotFound         = 3
+	ExitUnauthorized     = 4
+	ExitConflict         = 5
+	ExitTimeout          = 6
+	ExitConditionFailed  = 7
+	ExitServerError      = 8
+	ExitRolledBack       = 9
+	ExitRollbackFailed   = 10
+)
+
+var exitCodes = map[Type]int{
+	TypeGeneral:          ExitGeneral,
+	TypeValidationFailed: ExitValidationFailed,
+	TypeNotFound:         ExitNotFound,
+	TypeUnauthorized:     ExitUnauthorized,
+	TypeConflict:         ExitConflict,
+	TypeTimeout:          ExitTimeout,
+	TypeConditionFailed:  ExitConditionFailed,
+	TypeServerError:      ExitServerError,
+	TypeRolledBack:       ExitRolledBack,
+	TypeRollbackFailed:   ExitRollbackFailed,
+}
+
+// Error is a classified maestro-cli error
+type Error struct {
+	Type Type
+	// Code is the Maestro API error code (e.g. "maestro-7"), when the error came from the HTTP API
+	Code string
+	// Reason is the reason reported by the Maestro API or Kubernetes status, when available
+	Reason string
+	Err    error
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// ExitCode returns the process exit code for the error type
+func (e *Error) ExitCode() int {
+	if code, ok := exitCodes[e.Type]; ok {
+		return code
+	}
+	return ExitGeneral
+}
+
+// Typed is implemented by errors that know their own type, such as condition syntax errors
+type Typed interface {
+	ErrorType() Type
+}
+
+// HTTPStatusError is implemented by errors that carry the HTTP status of a Maestro API response
+type HTTPStatusError interface {
+	HTTPStatus() int
+}
+
+// New creates an error of the given type with a formatted message
+func New(t Type, format string, args ...interface{}) *Error {
+	return &Error{Type: t, Err: fmt.Errorf(format, args...)}
+}
+
+// Wrap classifies err as the given type, keeping its message
+// Returns nil if err is nil
+func Wrap(t Type, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{Type: t, Err: err}
+}
+
+// NewValidationFailed creates a ValidationFailed error
+func NewValidationFailed(format string, args ...interface{}) *Error {
+	return New(TypeValidationFailed, format, args...)
+}
+
+// NewNotFound creates a NotFound error
+func NewNotFound(format string, args ...interface{}) *Error {
+	return New(TypeNotFound, format, args...)
+}
+
+// NewConditionFailed creates a ConditionFailed error
+func NewConditionFailed(format string, args ...interface{}) *Error {
+	return New(TypeConditionFailed, format, args...)
+}
+
+// NewTimeout creates a Timeout error
+func NewTimeout(format string, args ...interface{}) *Error {
+	return New(TypeTimeout, format, args...)
+}
+
+// FromError classifies err, inspecting the whole error chain
+// Already classified errors are returned as is; unrecognized errors are TypeGeneral
+func FromError(err error) *Error {
+	if err == nil {
+		return nil
+	}
+
+	var cliErr *Error
+	if stderrors.As(err, &cliErr) {
+		if cliErr == err {
+			return cliErr
+		}
+		// Keep the outer message, which carries the context added by callers
+		return &Error{Type: cliErr.Type, Code: cliErr.Code, Reason: cliErr.Reason, Err: err}
+	}
+
+	classified := &Error{Type: TypeGeneral, Err: err}
+
+	var typed Typed
+	if stderrors.As(err, &typed) {
+		classified.Type = typed.ErrorType()
+		return classified
+	}
+
+	// Maestro HTTP API errors: take the code and reason from the response body
+	status := 0
+	var openAPIErr *openapi.GenericOpenAPIError
+	if stderrors.As(err, &openAPIErr) {
+		if model, ok := openAPIErr.Model().(openapi.Error); ok {
+			if model.Code != nil {
+				classified.Code = *model.Code
+			}
+			if model.Reason != nil {
+				classified.Reason = *model.Reason
+			}
+		}
+		status = parseStatus(openAPIErr.Error())
+	}
+	var statusErr HTTPStatusError
+	if stderrors.As(err, &statusErr) && statusErr.HTTPStatus() != 0 {
+		status = statusErr.HTTPStatus()
+	}
+	if status != 0 {
+		classified.Type = typeForStatus(status)
+		return classified
+	}
+
+	// Kubernetes API errors returned by the ManifestWork client
+	if apiStatus, ok := apiStatusOf(err); ok {
+		classified.Reason = string(apierrors.ReasonForError(err))
+		switch {
+		case apierrors.IsNotFound(err):
+			classified.Type = TypeNotFound
+		case apierrors.IsUnauthorized(err), apierrors.IsForbidden(err):
+			classified.Type = TypeUnauthorized
+		case apierrors.IsConflict(err), apierrors.IsAlreadyExists(err):
+			classified.Type = TypeConflict
+		case apierrors.IsTimeout(err), apierrors.IsServerTimeout(err):
+			classified.Type = TypeTimeout
+		case apierrors.IsInvalid(err), apierrors.IsBadRequest(err):
+			classified.Type = TypeValidationFailed
+		default:
+			classified.Type = typeForStatus(int(apiStatus.Status().Code))
+		}
+		return classified
+	}
+
+	if stderrors.Is(err, context.DeadlineExceeded) {
+		classified.Type = TypeTimeout
+		return classified
+	}
+	var netErr net.Error
+	if stderrors.As(err, &netErr) && netErr.Timeout() {
+		classified.Type = TypeTimeout
+	}
+
+	return classified
+}
+
+// ExitCode returns the process exit code for err (ExitSuccess for nil)
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitSuccess
+	}
+	return FromError(err).ExitCode()
+}
+
+// typeForStatus maps an HTTP status code to an error type
+func typeForStatus(status int) Type {
+	switch {
+	case status == http.StatusNotFound:
+		return TypeNotFound
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return TypeUnauthorized
+	case status == http.StatusConflict:
+		return TypeConflict
+	case status == http.StatusRequestTimeout || status == http.StatusGatewayTimeout:
+		return TypeTimeout
+	case status == http.StatusBadRequest || status == http.StatusUnprocessableEntity:
+		return TypeValidationFailed
+	case status == http.StatusTooManyRequests || status >= http.StatusInternalServerError:
+		return TypeServerError
+	default:
+		return TypeGeneral
+	}
+}
+
+// parseStatus extracts the status code from an HTTP status line such as "404 Not Found"
+func parseStatus(status string) int {
+	fields := strings.Fields(status)
+	if len(fields) == 0 {
+		return 0
+	}
+	code, err := strconv.Atoi(fields[0])
+	if err != nil || code < 100 || code > 599 {
+		return 0
+	}
+	return code
+}
+
+// apiStatusOf returns the Kubernetes API status carried by err, if any
+func apiStatusOf(err error) (apierrors.APIStatus, bool) {
+	var apiStatus apierrors.APIStatus
+	if stderrors.As(err, &apiStatus) {
+		return apiStatus, true
+	}
+	return nil, false
+}
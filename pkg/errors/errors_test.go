@@ -0,0 +1,131 @@
+package errors_test
+
+// This is an external test package: the tests use errors of the maestro package, which imports this one
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/openshift-hyperfleet/maestro-cli/internal/maestro"
+	clierrors "github.com/openshift-hyperfleet/maestro-cli/pkg/errors"
+)
+
+func TestFromError(t *testing.T) {
+	// apiError wraps a Maestro HTTP API error with the given status, as returned by the client
+	apiError := func(status int) error {
+		return fmt.Errorf("failed to get ManifestWork: %w", &maestro.APIError{
+			Op:         "failed to search resource bundles",
+			StatusCode: status,
+			Err:        stderrors.New("request failed"),
+		})
+	}
+	resource := schema.GroupResource{Group: "work.open-cluster-management.io", Resource: "manifestworks"}
+	_, syntaxErr := maestro.ParseCondition("Available AND")
+
+	tests := []struct {
+		name     string
+		err      error
+		expected clierrors.Type
+		exitCode int
+	}{
+		{"API error 400", apiError(400), clierrors.TypeValidationFailed, clierrors.ExitValidationFailed},
+		{"API error 401", apiError(401), clierrors.TypeUnauthorized, clierrors.ExitUnauthorized},
+		{"API error 403", apiError(403), clierrors.TypeUnauthorized, clierrors.ExitUnauthorized},
+		{"API error 404", apiError(404), clierrors.TypeNotFound, clierrors.ExitNotFound},
+		{"API error 409", apiError(409), clierrors.TypeConflict, clierrors.ExitConflict},
+		{"API error 429", apiError(429), clierrors.TypeServerError, clierrors.ExitServerError},
+		{"API error 500", apiError(500), clierrors.TypeServerError, clierrors.ExitServerError},
+		{"API error 503", apiError(503), clierrors.TypeServerError, clierrors.ExitServerError},
+		{"API error without response", apiError(0), clierrors.TypeGeneral, clierrors.ExitGeneral},
+		{
+			"status error not found",
+			apierrors.NewNotFound(resource, "test-mw"),
+			clierrors.TypeNotFound,
+			clierrors.ExitNotFound,
+		},
+		{
+			"status error forbidden",
+			apierrors.NewForbidden(resource, "test-mw", stderrors.New("denied")),
+			clierrors.TypeUnauthorized,
+			clierrors.ExitUnauthorized,
+		},
+		{
+			"status error already exists",
+			fmt.Errorf("failed to create: %w", apierrors.NewAlreadyExists(resource, "test-mw")),
+			clierrors.TypeConflict,
+			clierrors.ExitConflict,
+		},
+		{
+			"status error bad request",
+			apierrors.NewBadRequest("invalid patch"),
+			clierrors.TypeValidationFailed,
+			clierrors.ExitValidationFailed,
+		},
+		{
+			"status error timeout",
+			apierrors.NewTimeoutError("no response", 1),
+			clierrors.TypeTimeout,
+			clierrors.ExitTimeout,
+		},
+		{
+			"status error unavailable",
+			apierrors.NewServiceUnavailable("connection lost"),
+			clierrors.TypeServerError,
+			clierrors.ExitServerError,
+		},
+		{
+			"deadline exceeded",
+			fmt.Errorf("failed waiting for condition: %w", context.DeadlineExceeded),
+			clierrors.TypeTimeout,
+			clierrors.ExitTimeout,
+		},
+		{"cancelled", context.Canceled, clierrors.TypeGeneral, clierrors.ExitGeneral},
+		{"condition syntax error", syntaxErr, clierrors.TypeValidationFailed, clierrors.ExitValidationFailed},
+		{
+			"condition failed error",
+			&maestro.ConditionFailedError{Name: "test-mw", FailOn: "Degraded", Matched: "Degraded"},
+			clierrors.TypeConditionFailed,
+			clierrors.ExitConditionFailed,
+		},
+		{
+			"version conflict error",
+			fmt.Errorf("apply failed: %w", &maestro.VersionConflictError{Name: "test-mw", Reason: "version 3 is current"}),
+			clierrors.TypeConflict,
+			clierrors.ExitConflict,
+		},
+		{
+			"classified error",
+			fmt.Errorf("wait failed: %w", clierrors.NewNotFound("ManifestWork test-mw not found")),
+			clierrors.TypeNotFound,
+			clierrors.ExitNotFound,
+		},
+		{"unclassified error", stderrors.New("boom"), clierrors.TypeGeneral, clierrors.ExitGeneral},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.err == nil {
+				t.Fatal("test case has no error")
+			}
+			cliErr := clierrors.FromError(tt.err)
+			if cliErr.Type != tt.expected {
+				t.Errorf("FromError(%v).Type = %s, expected %s", tt.err, cliErr.Type, tt.expected)
+			}
+			if cliErr.ExitCode() != tt.exitCode || clierrors.ExitCode(tt.err) != tt.exitCode {
+				t.Errorf("exit code = %d, expected %d", cliErr.ExitCode(), tt.exitCode)
+			}
+			// The message of the outermost error is kept, with the context added by callers
+			if cliErr.Error() != tt.err.Error() {
+				t.Errorf("message = %q, expected %q", cliErr.Error(), tt.err.Error())
+			}
+		})
+	}
+
+	if clierrors.FromError(nil) != nil || clierrors.ExitCode(nil) != clierrors.ExitSuccess {
+		t.Error("expected no error and exit code 0 for nil")
+	}
+}